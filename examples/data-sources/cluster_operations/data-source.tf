@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    cratedb = {
+      source = "thulasirajkomminar/cratedb"
+    }
+  }
+}
+
+data "cratedb_cluster_operations" "failed" {
+  cluster_id = "a4d6e5b0-5c6d-4f2e-8a1b-2c3d4e5f6a7b"
+  status     = "FAILED"
+}
+
+output "failed_operations" {
+  value = {
+    for operation in data.cratedb_cluster_operations.failed.operations :
+    operation.id => "${operation.type}: ${operation.feedback}"
+  }
+}
@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    cratedb = {
+      source = "thulasirajkomminar/cratedb"
+    }
+  }
+}
+
+data "cratedb_cluster_snapshots" "default" {
+  cluster_id = "a4d6e5b0-5c6d-4f2e-8a1b-2c3d4e5f6a7b"
+}
+
+output "latest_snapshot" {
+  value = data.cratedb_cluster_snapshots.default.snapshots[0].snapshot
+}
@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    cratedb = {
+      source = "thulasirajkomminar/cratedb"
+    }
+  }
+}
+
+data "cratedb_clusters" "production" {
+  project_id    = "a99eb2a8-bcf5-418c-866f-67e65a8ada40"
+  name_regex    = "^prod-"
+  health_status = "GREEN"
+}
+
+output "production_cluster_urls" {
+  value = { for cluster in data.cratedb_clusters.production.clusters : cluster.name => cluster.url }
+}
@@ -0,0 +1,16 @@
+terraform {
+  required_providers {
+    cratedb = {
+      source = "thulasirajkomminar/cratedb"
+    }
+  }
+}
+
+# The latest stable 5.x version, e.g. as crate_version of a cluster.
+data "cratedb_crate_versions" "five" {
+  version_prefix = "5."
+}
+
+output "crate_version" {
+  value = data.cratedb_crate_versions.five.latest_version
+}
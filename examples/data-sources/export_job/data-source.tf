@@ -0,0 +1,16 @@
+terraform {
+  required_providers {
+    cratedb = {
+      source = "thulasirajkomminar/cratedb"
+    }
+  }
+}
+
+data "cratedb_export_job" "default" {
+  cluster_id = "a4d6e5b0-5c6d-4f2e-8a1b-2c3d4e5f6a7b"
+  id         = "9c2d4e6f-1a3b-4c5d-8e7f-0a1b2c3d4e5f"
+}
+
+output "export_file" {
+  value = data.cratedb_export_job.default.file_name
+}
@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    cratedb = {
+      source = "thulasirajkomminar/cratedb"
+    }
+  }
+}
+
+data "cratedb_organization_secrets" "default" {
+  organization_id = "667796de-3c06-4503-bc3c-a9adc2a849cc"
+}
+
+output "secret_names" {
+  value = data.cratedb_organization_secrets.default.secrets[*].name
+}
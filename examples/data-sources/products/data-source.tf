@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    cratedb = {
+      source = "thulasirajkomminar/cratedb"
+    }
+  }
+}
+
+data "cratedb_products" "cr4" {
+  region = "aks1.westeurope.azure"
+  name   = "cr4"
+  tier   = "default"
+}
+
+locals {
+  product = data.cratedb_products.cr4.products[0]
+}
+
+# Use the largest size of the product for a cluster.
+output "product_unit" {
+  value = max([for size in local.product.scaling : size.product_unit]...)
+}
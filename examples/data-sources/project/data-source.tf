@@ -13,3 +13,8 @@ data "cratedb_project" "default" {
 output "default_project" {
   value = data.cratedb_project.default
 }
+
+data "cratedb_project" "by_name" {
+  name            = "default"
+  organization_id = "667796de-3c06-4503-bc3c-a9adc2a849cc"
+}
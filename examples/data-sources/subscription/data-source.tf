@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    cratedb = {
+      source = "thulasirajkomminar/cratedb"
+    }
+  }
+}
+
+# The active subscription of an organization, billed through Stripe.
+data "cratedb_subscription" "default" {
+  organization_id  = "667796de-3c06-4503-bc3c-a9adc2a849cc"
+  billing_provider = "stripe"
+}
+
+output "subscription_id" {
+  value = data.cratedb_subscription.default.id
+}
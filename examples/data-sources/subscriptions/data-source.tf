@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    cratedb = {
+      source = "thulasirajkomminar/cratedb"
+    }
+  }
+}
+
+data "cratedb_subscriptions" "active" {
+  organization_id = "667796de-3c06-4503-bc3c-a9adc2a849cc"
+  active          = true
+}
+
+output "subscriptions" {
+  value = {
+    for subscription in data.cratedb_subscriptions.active.subscriptions :
+    subscription.id => "${subscription.billing_provider} (${subscription.state})"
+  }
+}
@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    cratedb = {
+      source = "thulasirajkomminar/cratedb"
+    }
+  }
+}
+
+variable "cluster_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+ephemeral "cratedb_cluster_connection" "default" {
+  cluster_id = "156e7f96-0f6e-4fcc-8940-6e2a52efcee3"
+  password   = var.cluster_password
+}
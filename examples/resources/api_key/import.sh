@@ -0,0 +1,2 @@
+# API keys are imported by their key. The secret cannot be recovered on import.
+terraform import cratedb_api_key.ci "wsbkllzqvkvp"
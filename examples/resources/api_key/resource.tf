@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    cratedb = {
+      source = "thulasirajkomminar/cratedb"
+    }
+  }
+}
+
+provider "cratedb" {}
+
+resource "cratedb_api_key" "ci" {
+  active = true
+}
+
+output "ci_api_secret" {
+  value     = cratedb_api_key.ci.secret
+  sensitive = true
+}
@@ -8,6 +8,12 @@ terraform {
 
 provider "cratedb" {}
 
+variable "cluster_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
 resource "cratedb_cluster" "default" {
   organization_id = "667796de-3c06-4503-bc3c-a9adc2a849cc"
   crate_version   = "5.8.2"
@@ -17,7 +23,68 @@ resource "cratedb_cluster" "default" {
   project_id      = "a99eb2a8-bcf5-418c-866f-67e65a8ada40"
   subscription_id = "7c156ae9-9c07-4106-8f42-df93855876c1"
   username        = "admin"
-  password        = "zyTChd9mfcGBFLb72nJkNeVj6"
+
+  # Write-only, so the password never lands in the state (Terraform 1.11+).
+  # Bump the version to apply a new password.
+  password_wo         = var.cluster_password
+  password_wo_version = 1
+
+  ip_whitelist = [
+    {
+      cidr        = "192.168.0.0/24"
+      description = "Office network"
+    },
+  ]
+
+  timeouts {
+    create = "90m"
+  }
+}
+
+# Clone the latest snapshot of the orders table of a production cluster into
+# a staging project.
+resource "cratedb_cluster" "staging" {
+  organization_id = "667796de-3c06-4503-bc3c-a9adc2a849cc"
+  crate_version   = "5.8.2"
+  name            = "staging-cluster"
+  product_name    = "cr4"
+  product_tier    = "default"
+  project_id      = "0f6f8a0e-6a3c-4b2e-9d1f-3a5b7c9d1e2f"
+  subscription_id = "7c156ae9-9c07-4106-8f42-df93855876c1"
+  username        = "admin"
+
+  password_wo         = var.cluster_password
+  password_wo_version = 1
+
+  restore_from = {
+    cluster_id = "a4d6e5b0-5c6d-4f2e-8a1b-2c3d4e5f6a7b"
+    tables     = ["doc.orders"]
+  }
+}
+
+# Recover a cluster from a listed snapshot of another cluster.
+data "cratedb_cluster_snapshots" "production" {
+  cluster_id = "a4d6e5b0-5c6d-4f2e-8a1b-2c3d4e5f6a7b"
+}
+
+resource "cratedb_cluster" "recovery" {
+  organization_id = "667796de-3c06-4503-bc3c-a9adc2a849cc"
+  crate_version   = "5.8.2"
+  name            = "recovery-cluster"
+  product_name    = "cr4"
+  product_tier    = "default"
+  project_id      = "a99eb2a8-bcf5-418c-866f-67e65a8ada40"
+  subscription_id = "7c156ae9-9c07-4106-8f42-df93855876c1"
+  username        = "admin"
+
+  password_wo         = var.cluster_password
+  password_wo_version = 1
+
+  restore_from = {
+    cluster_id = data.cratedb_cluster_snapshots.production.cluster_id
+    snapshot   = data.cratedb_cluster_snapshots.production.snapshots[0].snapshot
+    repository = data.cratedb_cluster_snapshots.production.snapshots[0].repository
+  }
 }
 
 output "default_cluster" {
@@ -0,0 +1,2 @@
+# Export jobs are imported by the cluster id and the export job id, separated by a slash.
+terraform import cratedb_export_job.orders "a4d6e5b0-5c6d-4f2e-8a1b-2c3d4e5f6a7b/9c2d4e6f-1a3b-4c5d-8e7f-0a1b2c3d4e5f"
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    cratedb = {
+      source = "thulasirajkomminar/cratedb"
+    }
+  }
+}
+
+provider "cratedb" {}
+
+resource "cratedb_export_job" "orders" {
+  cluster_id  = "a4d6e5b0-5c6d-4f2e-8a1b-2c3d4e5f6a7b"
+  table       = "doc.orders"
+  format      = "parquet"
+  compression = "gzip"
+}
+
+# The download URL is pre-signed and grants access to the exported data.
+output "orders_export_url" {
+  value     = cratedb_export_job.orders.download_url
+  sensitive = true
+}
@@ -0,0 +1,2 @@
+# Files are imported by the organization id and the file id, separated by a slash.
+terraform import cratedb_file.countries "667796de-3c06-4503-bc3c-a9adc2a849cc/2f4e6a8c-0b1d-4e3f-9a5b-7c9d1e3f5a7b"
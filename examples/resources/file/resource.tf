@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    cratedb = {
+      source = "thulasirajkomminar/cratedb"
+    }
+  }
+}
+
+provider "cratedb" {}
+
+resource "cratedb_file" "countries" {
+  organization_id = "667796de-3c06-4503-bc3c-a9adc2a849cc"
+  source          = "${path.module}/data/countries.csv"
+}
+
+# Changing the content of countries.csv uploads it again, which replaces the
+# file and, through the reference, re-runs the import.
+resource "cratedb_import_job" "countries" {
+  cluster_id = "a4d6e5b0-5c6d-4f2e-8a1b-2c3d4e5f6a7b"
+  table      = "countries"
+  format     = "csv"
+
+  file = {
+    id = cratedb_file.countries.id
+  }
+}
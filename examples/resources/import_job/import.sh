@@ -0,0 +1,2 @@
+# Import jobs are imported by the cluster id and the import job id, separated by a slash.
+terraform import cratedb_import_job.weather "a4d6e5b0-5c6d-4f2e-8a1b-2c3d4e5f6a7b/5e8f2a1c-7b3d-4c9e-a6f0-1d2b3c4e5f60"
@@ -0,0 +1,39 @@
+terraform {
+  required_providers {
+    cratedb = {
+      source = "thulasirajkomminar/cratedb"
+    }
+  }
+}
+
+provider "cratedb" {}
+
+# Import a public CSV file into a new table.
+resource "cratedb_import_job" "weather" {
+  cluster_id  = "a4d6e5b0-5c6d-4f2e-8a1b-2c3d4e5f6a7b"
+  table       = "weather_data"
+  format      = "csv"
+  compression = "gzip"
+
+  url = {
+    url = "https://github.com/crate/cratedb-datasets/raw/main/cloud-tutorials/data_weather.csv.gz"
+  }
+}
+
+# Import all Parquet files below a prefix of an S3 bucket, using the
+# credentials stored in an organization secret (see cratedb_organization_secret).
+resource "cratedb_import_job" "reference_data" {
+  cluster_id = "a4d6e5b0-5c6d-4f2e-8a1b-2c3d4e5f6a7b"
+  table      = "reference_data"
+  format     = "parquet"
+
+  s3 = {
+    bucket    = "example-reference-data"
+    file_path = "exports/*.parquet"
+    secret_id = "3b3a8c1e-5b7d-4f0e-9d2a-6c1f8e4b7a90"
+  }
+
+  timeouts {
+    create = "2h"
+  }
+}
@@ -0,0 +1,5 @@
+# Organization members are imported by the organization id and the user id, separated by a slash.
+terraform import cratedb_organization_member.default "667796de-3c06-4503-bc3c-a9adc2a849cc/0f1e3c5d-8a2b-4c6d-9e7f-1a2b3c4d5e6f"
+
+# Members can also be imported by their email address. Pending invitations are not listed by CrateDB Cloud and cannot be imported.
+terraform import cratedb_organization_member.default "667796de-3c06-4503-bc3c-a9adc2a849cc/jane.doe@example.com"
@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    cratedb = {
+      source = "thulasirajkomminar/cratedb"
+    }
+  }
+}
+
+provider "cratedb" {}
+
+resource "cratedb_organization_member" "default" {
+  organization_id = "667796de-3c06-4503-bc3c-a9adc2a849cc"
+  email           = "jane.doe@example.com"
+  role            = "org_member"
+}
@@ -0,0 +1,2 @@
+# Organization secrets are imported by the organization id and the secret id, separated by a slash.
+terraform import cratedb_organization_secret.s3 "667796de-3c06-4503-bc3c-a9adc2a849cc/3b3a8c1e-5b7d-4f0e-9d2a-6c1f8e4b7a90"
@@ -0,0 +1,2 @@
+# Project members are imported by the project id and the user id, separated by a slash.
+terraform import cratedb_project_member.default "a99eb2a8-bcf5-418c-866f-67e65a8ada40/0f1e3c5d-8a2b-4c6d-9e7f-1a2b3c4d5e6f"
@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    cratedb = {
+      source = "thulasirajkomminar/cratedb"
+    }
+  }
+}
+
+provider "cratedb" {}
+
+resource "cratedb_organization_member" "default" {
+  organization_id = "667796de-3c06-4503-bc3c-a9adc2a849cc"
+  email           = "jane.doe@example.com"
+  role            = "org_member"
+}
+
+resource "cratedb_project_member" "default" {
+  project_id = "a99eb2a8-bcf5-418c-866f-67e65a8ada40"
+  user_id    = cratedb_organization_member.default.user_id
+  role       = "project_admin"
+}
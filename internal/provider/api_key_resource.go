@@ -0,0 +1,397 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ApiKeyResource{}
+	_ resource.ResourceWithConfigure   = &ApiKeyResource{}
+	_ resource.ResourceWithImportState = &ApiKeyResource{}
+)
+
+// apiApiKey mirrors cratedb.ApiKey with tolerant timestamp decoding.
+type apiApiKey struct {
+	Active   *bool          `json:"active"`
+	Dc       *apiDublinCore `json:"dc"`
+	Key      *string        `json:"key"`
+	LastUsed *apiTime       `json:"last_used"`
+	UserId   *string        `json:"user_id"`
+}
+
+// apiNewApiKey mirrors cratedb.NewApiKeyResponseSchema. The secret is only
+// returned when the key is created.
+type apiNewApiKey struct {
+	Key    *apiApiKey `json:"key"`
+	Secret *string    `json:"secret"`
+}
+
+// NewApiKeyResource is a helper function to simplify the provider implementation.
+func NewApiKeyResource() resource.Resource {
+	return &ApiKeyResource{}
+}
+
+// ApiKeyResource defines the resource implementation. The API keys of CrateDB
+// Cloud have no description, only whether they are active can be changed.
+type ApiKeyResource struct {
+	client *cratedb.ClientWithResponses
+}
+
+// ApiKeyModel maps CrateDB API key schema data.
+type ApiKeyModel struct {
+	Active   types.Bool        `tfsdk:"active"`
+	Dc       types.Object      `tfsdk:"dc"`
+	Id       types.String      `tfsdk:"id"`
+	Key      types.String      `tfsdk:"key"`
+	LastUsed timetypes.RFC3339 `tfsdk:"last_used"`
+	Secret   types.String      `tfsdk:"secret"`
+	UserId   types.String      `tfsdk:"user_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ApiKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+// Schema defines the schema for the resource.
+func (r *ApiKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Creates and manages an API key of the user the provider authenticates as. The key and secret can be used as `api_key` and `api_secret` of the provider. " +
+			"CrateDB Cloud API keys have no name or description, so only whether a key is `active` can be managed.",
+
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Whether the API key can be used. New keys are active unless this is `false`.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The id of the API key, which is the key itself.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Description: "The API key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_used": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Computed:    true,
+				Description: "When the API key was last used.",
+			},
+			"secret": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API secret. It is only returned when the key is created, so it is null for imported keys.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The id of the user the API key belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dc": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The DublinCore of the API key.",
+				Attributes: map[string]schema.Attribute{
+					"created": schema.StringAttribute{
+						CustomType:  timetypes.RFC3339Type{},
+						Computed:    true,
+						Description: "The created time.",
+					},
+					"modified": schema.StringAttribute{
+						CustomType:  timetypes.RFC3339Type{},
+						Computed:    true,
+						Description: "The modified time.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ApiKeyModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	active := plan.Active
+
+	// Use the raw client and decode the body with tolerant timestamp types
+	createApiKeyResponse, err := r.client.PostApiV2UsersMeApiKeys(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating API key",
+			"Could not create API key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var newApiKey apiNewApiKey
+	if err := decodeApiResponse(createApiKeyResponse, http.StatusOK, &newApiKey); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating API key",
+			err.Error(),
+		)
+		return
+	}
+
+	if newApiKey.Key == nil || newApiKey.Key.Key == nil {
+		resp.Diagnostics.AddError(
+			"Error creating API key",
+			"The API did not return the created key.",
+		)
+		return
+	}
+	apiKey := *newApiKey.Key
+
+	// Deactivate the key if requested
+	if !active.IsUnknown() && !active.IsNull() && active.ValueBool() != (apiKey.Active == nil || *apiKey.Active) {
+		updatedApiKey, err := r.setActive(ctx, *apiKey.Key, active.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating API key",
+				"Could not update API key, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		apiKey = *updatedApiKey
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	apiKeyPlan, err := getApiKeyModel(ctx, apiKey)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting API key model",
+			err.Error(),
+		)
+		return
+	}
+	plan = *apiKeyPlan
+	plan.Secret = types.StringPointerValue(newApiKey.Secret)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state ApiKeyModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed API key value from API
+	secret := state.Secret
+	readApiKeyResponse, err := r.client.GetApiV2UsersMeApiKeysApiKey(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting API key",
+			err.Error(),
+		)
+		return
+	}
+
+	// If the API key no longer exists, remove it from state so Terraform
+	// plans a re-create instead of failing the refresh.
+	if isNotFound(readApiKeyResponse) {
+		_ = readApiKeyResponse.Body.Close()
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var apiKey apiApiKey
+	if err := decodeApiResponse(readApiKeyResponse, http.StatusOK, &apiKey); err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting API key",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	apiKeyState, err := getApiKeyModel(ctx, apiKey)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting API key model",
+			err.Error(),
+		)
+		return
+	}
+	// Overwrite items with refreshed state
+	state = *apiKeyState
+	state.Secret = secret
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ApiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ApiKeyModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only active can be changed in place
+	updated := state
+	if !plan.Active.IsUnknown() && !plan.Active.Equal(state.Active) {
+		apiKey, err := r.setActive(ctx, state.Id.ValueString(), plan.Active.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating API key",
+				"Could not update API key, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		apiKeyPlan, err := getApiKeyModel(ctx, *apiKey)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error getting API key model",
+				err.Error(),
+			)
+			return
+		}
+		updated = *apiKeyPlan
+		updated.Secret = state.Secret
+	}
+	plan = updated
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ApiKeyModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing API key
+	deleteApiKeyResponse, err := r.client.DeleteApiV2UsersMeApiKeysApiKeyWithResponse(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting API key",
+			"Could not delete API key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// An API key already deleted out-of-band is fine: the desired outcome
+	// (no API key) is achieved.
+	if isNotFound(deleteApiKeyResponse.HTTPResponse) {
+		return
+	}
+
+	if deleteApiKeyResponse.StatusCode() != 204 {
+		resp.Diagnostics.AddError(
+			"Error deleting API key",
+			apiErrorDetail(deleteApiKeyResponse.HTTPResponse, deleteApiKeyResponse.Body),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ApiKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := clientFromProviderData(req.ProviderData, "Resource", &resp.Diagnostics); client != nil {
+		r.client = client
+	}
+}
+
+func (r *ApiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Read refreshes the API key by its key, so the import identifier is the
+	// key. The secret cannot be recovered.
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setActive switches the active flag of an API key.
+func (r *ApiKeyResource) setActive(ctx context.Context, key string, active bool) (*apiApiKey, error) {
+	updateApiKeyResponse, err := r.client.PatchApiV2UsersMeApiKeysApiKey(ctx, key, cratedb.EditApiKeySchema{
+		Active: &active,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var apiKey apiApiKey
+	if err := decodeApiResponse(updateApiKeyResponse, http.StatusOK, &apiKey); err != nil {
+		return nil, err
+	}
+	return &apiKey, nil
+}
+
+func getApiKeyModel(ctx context.Context, apiKey apiApiKey) (*ApiKeyModel, error) {
+	dcObjectValue, err := getDCObjectValue(ctx, apiKey.Dc.dublinCore())
+	if err != nil {
+		return nil, fmt.Errorf("error getting API key DC value: %w", err)
+	}
+
+	lastUsed := timetypes.NewRFC3339Null()
+	if apiKey.LastUsed != nil {
+		lastUsed = timetypes.NewRFC3339TimeValue(apiKey.LastUsed.Time)
+	}
+
+	return &ApiKeyModel{
+		Active:   types.BoolPointerValue(apiKey.Active),
+		Dc:       dcObjectValue,
+		Id:       types.StringPointerValue(apiKey.Key),
+		Key:      types.StringPointerValue(apiKey.Key),
+		LastUsed: lastUsed,
+		Secret:   types.StringNull(),
+		UserId:   types.StringPointerValue(apiKey.UserId),
+	}, nil
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApiKeyResource(t *testing.T) {
+	apiKeyConfig := func(active bool) string {
+		return testAccProviderConfig + fmt.Sprintf(`
+resource "cratedb_api_key" "test" {
+  active = %t
+}
+`, active)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: apiKeyConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cratedb_api_key.test", "active", "true"),
+					resource.TestCheckResourceAttrPair("cratedb_api_key.test", "key", "cratedb_api_key.test", "id"),
+					resource.TestCheckResourceAttrSet("cratedb_api_key.test", "secret"),
+					resource.TestCheckResourceAttrSet("cratedb_api_key.test", "user_id"),
+				),
+			},
+			// ImportState testing. The secret is only returned on creation.
+			{
+				ResourceName:            "cratedb_api_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret", "last_used"},
+			},
+			// Update and Read testing
+			{
+				Config: apiKeyConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cratedb_api_key.test", "active", "false"),
+					resource.TestCheckResourceAttrSet("cratedb_api_key.test", "secret"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &ClusterConnectionEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &ClusterConnectionEphemeralResource{}
+)
+
+// Ports CrateDB Cloud clusters accept PostgreSQL wire protocol and HTTP
+// connections on.
+const (
+	clusterPsqlPort = 5432
+	clusterHttpPort = 4200
+)
+
+// NewClusterConnectionEphemeralResource is a helper function to simplify the provider implementation.
+func NewClusterConnectionEphemeralResource() ephemeral.EphemeralResource {
+	return &ClusterConnectionEphemeralResource{}
+}
+
+// ClusterConnectionEphemeralResource is the ephemeral resource implementation.
+type ClusterConnectionEphemeralResource struct {
+	client *cratedb.ClientWithResponses
+}
+
+// ClusterConnectionEphemeralResourceModel describes the ephemeral resource data model.
+type ClusterConnectionEphemeralResourceModel struct {
+	ClusterId   types.String      `tfsdk:"cluster_id"`
+	Password    types.String      `tfsdk:"password"`
+	Host        types.String      `tfsdk:"host"`
+	PsqlPort    types.Int32       `tfsdk:"psql_port"`
+	HttpPort    types.Int32       `tfsdk:"http_port"`
+	Username    types.String      `tfsdk:"username"`
+	PsqlUrl     types.String      `tfsdk:"psql_url"`
+	HttpUrl     types.String      `tfsdk:"http_url"`
+	Token       types.String      `tfsdk:"token"`
+	TokenExpiry timetypes.RFC3339 `tfsdk:"token_expiry"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *ClusterConnectionEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_connection"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *ClusterConnectionEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "To retrieve the connection details of a cluster and a short-lived access token for it, without storing them in the Terraform state or plan. Requires Terraform 1.10 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the cluster.",
+			},
+			"password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The password of the cluster user, as a fallback for clients that cannot authenticate with `token`. CrateDB Cloud never returns cluster passwords, so pass the `password` of the cluster or the value given to its `password_wo`. When omitted, the URLs only contain the username.",
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "The host name of the cluster.",
+			},
+			"psql_port": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The port of the PostgreSQL wire protocol.",
+			},
+			"http_port": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The port of the HTTP endpoint.",
+			},
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "The username of the cluster.",
+			},
+			"psql_url": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PostgreSQL connection URL of the cluster, including the credentials.",
+			},
+			"http_url": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The HTTP URL of the cluster, including the credentials.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "A short-lived JSON Web Token for the cluster, issued by CrateDB Cloud for the user the provider authenticates as. Send it as `Authorization: Bearer <token>` header to the HTTP endpoint. Not set when the token could not be issued and `password` is given.",
+			},
+			"token_expiry": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Computed:    true,
+				Description: "The time `token` expires.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (e *ClusterConnectionEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if client := clientFromProviderData(req.ProviderData, "Ephemeral Resource", &resp.Diagnostics); client != nil {
+		e.client = client
+	}
+}
+
+// Open reads the cluster and returns its connection details and a
+// short-lived token.
+func (e *ClusterConnectionEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ClusterConnectionEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readClusterResponse, err := e.client.GetApiV2ClustersClusterIdWithResponse(ctx, data.ClusterId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting cluster",
+			err.Error(),
+		)
+		return
+	}
+
+	if readClusterResponse.StatusCode() != 200 || readClusterResponse.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Error getting cluster",
+			apiErrorDetail(readClusterResponse.HTTPResponse, readClusterResponse.Body),
+		)
+		return
+	}
+
+	cluster := readClusterResponse.JSON200
+	if cluster.Fqdn == nil || *cluster.Fqdn == "" {
+		resp.Diagnostics.AddError(
+			"Error getting cluster connection",
+			"The cluster has no host name yet, it is probably still being deployed.",
+		)
+		return
+	}
+
+	host := strings.TrimSuffix(*cluster.Fqdn, ".")
+	psqlUrl, httpUrl := clusterConnectionUrls(host, cluster.Username, data.Password)
+
+	data.Host = types.StringValue(host)
+	data.PsqlPort = types.Int32Value(clusterPsqlPort)
+	data.HttpPort = types.Int32Value(clusterHttpPort)
+	data.Username = types.StringValue(cluster.Username)
+	data.PsqlUrl = types.StringValue(psqlUrl)
+	data.HttpUrl = types.StringValue(httpUrl)
+
+	// The password is only a fallback when no token can be issued.
+	data.Token = types.StringNull()
+	data.TokenExpiry = timetypes.NewRFC3339Null()
+	token, err := getClusterJwt(ctx, e.client, data.ClusterId.ValueString())
+	switch {
+	case err == nil:
+		data.Token = types.StringPointerValue(token.Token)
+		if token.Expiry != nil {
+			data.TokenExpiry = timetypes.NewRFC3339TimeValue(*token.Expiry)
+		}
+	case data.Password.IsNull():
+		resp.Diagnostics.AddError(
+			"Error getting cluster token",
+			"Could not get a token for the cluster, unexpected error: "+err.Error()+"\nPass the password of the cluster to connect with it instead.",
+		)
+		return
+	default:
+		resp.Diagnostics.AddWarning(
+			"Error getting cluster token",
+			"Could not get a token for the cluster, so only the password can be used to connect: "+err.Error(),
+		)
+	}
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// clusterConnectionUrls returns the PostgreSQL and HTTP URLs of a cluster
+// host. The password is left out when it is null.
+func clusterConnectionUrls(host, username string, password types.String) (string, string) {
+	user := url.User(username)
+	if !password.IsNull() && !password.IsUnknown() {
+		user = url.UserPassword(username, password.ValueString())
+	}
+
+	psqlUrl := url.URL{
+		Scheme:   "postgresql",
+		User:     user,
+		Host:     net.JoinHostPort(host, strconv.Itoa(clusterPsqlPort)),
+		Path:     "/doc",
+		RawQuery: "sslmode=require",
+	}
+	httpUrl := url.URL{
+		Scheme: "https",
+		User:   user,
+		Host:   net.JoinHostPort(host, strconv.Itoa(clusterHttpPort)),
+	}
+	return psqlUrl.String(), httpUrl.String()
+}
+
+// getClusterJwt requests a short-lived JSON Web Token for a cluster.
+func getClusterJwt(ctx context.Context, client *cratedb.ClientWithResponses, clusterId string) (*cratedb.ClusterJWTToken, error) {
+	clusterJwtResponse, err := client.GetApiV2ClustersClusterIdJwtWithResponse(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	if clusterJwtResponse.StatusCode() != 200 || clusterJwtResponse.JSON200 == nil {
+		return nil, errors.New(apiErrorDetail(clusterJwtResponse.HTTPResponse, clusterJwtResponse.Body))
+	}
+	if clusterJwtResponse.JSON200.Token == nil || *clusterJwtResponse.JSON200.Token == "" {
+		return nil, errors.New("the API did not return a token")
+	}
+	return clusterJwtResponse.JSON200, nil
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+func TestClusterConnectionUrls(t *testing.T) {
+	const host = "my-cluster.aks1.westeurope.azure.cratedb.net"
+
+	testCases := map[string]struct {
+		password    types.String
+		wantPsqlUrl string
+		wantHttpUrl string
+	}{
+		"with password": {
+			password:    types.StringValue("secret"),
+			wantPsqlUrl: "postgresql://admin:secret@" + host + ":5432/doc?sslmode=require",
+			wantHttpUrl: "https://admin:secret@" + host + ":4200",
+		},
+		"escapes password": {
+			password:    types.StringValue("p@ss/word:1"),
+			wantPsqlUrl: "postgresql://admin:p%40ss%2Fword%3A1@" + host + ":5432/doc?sslmode=require",
+			wantHttpUrl: "https://admin:p%40ss%2Fword%3A1@" + host + ":4200",
+		},
+		"without password": {
+			password:    types.StringNull(),
+			wantPsqlUrl: "postgresql://admin@" + host + ":5432/doc?sslmode=require",
+			wantHttpUrl: "https://admin@" + host + ":4200",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			psqlUrl, httpUrl := clusterConnectionUrls(host, "admin", testCase.password)
+			if psqlUrl != testCase.wantPsqlUrl {
+				t.Errorf("got psql url %q, want %q", psqlUrl, testCase.wantPsqlUrl)
+			}
+			if httpUrl != testCase.wantHttpUrl {
+				t.Errorf("got http url %q, want %q", httpUrl, testCase.wantHttpUrl)
+			}
+		})
+	}
+}
+
+func TestGetClusterJwt(t *testing.T) {
+	const clusterID = "7e1c3a2e-0000-4000-8000-000000000001"
+
+	testCases := map[string]struct {
+		status    int
+		body      string
+		wantToken string
+		wantErr   bool
+	}{
+		"issued": {
+			status:    http.StatusOK,
+			body:      `{"token":"eyJhbGciOiJSUzI1NiJ9.e30.c2ln","refresh":"refresh","expiry":"2026-07-10T11:41:02Z"}`,
+			wantToken: "eyJhbGciOiJSUzI1NiJ9.e30.c2ln",
+		},
+		"no token": {
+			status:  http.StatusOK,
+			body:    `{}`,
+			wantErr: true,
+		},
+		"forbidden": {
+			status:  http.StatusForbidden,
+			body:    `{"message":"Forbidden"}`,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v2/clusters/"+clusterID+"/jwt/" {
+					http.NotFound(w, r)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(testCase.status)
+				_, _ = w.Write([]byte(testCase.body))
+			}))
+			defer server.Close()
+
+			client, err := cratedb.NewClientWithResponses(server.URL)
+			if err != nil {
+				t.Fatalf("creating client: %v", err)
+			}
+
+			token, err := getClusterJwt(context.Background(), client, clusterID)
+			if (err != nil) != testCase.wantErr {
+				t.Fatalf("getClusterJwt() error = %v, wantErr %t", err, testCase.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if *token.Token != testCase.wantToken {
+				t.Errorf("got token %q, want %q", *token.Token, testCase.wantToken)
+			}
+			if token.Expiry == nil {
+				t.Error("expected the token expiry to be set")
+			}
+		})
+	}
+}
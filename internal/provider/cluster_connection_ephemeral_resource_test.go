@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TestAccClusterConnectionEphemeralResource opens a connection to an existing
+// cluster. Ephemeral values never reach the state, so the echo provider copies
+// them into a managed resource that the checks can read.
+func TestAccClusterConnectionEphemeralResource(t *testing.T) {
+	clusterID := envOrSkip(t, "CRATEDB_CLUSTER_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"cratedb": providerserver.NewProtocol6WithError(New("test")()),
+			"echo":    echoprovider.NewProviderServer(),
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + fmt.Sprintf(`
+ephemeral "cratedb_cluster_connection" "test" {
+  cluster_id = %q
+  password   = "not-the-real-password"
+}
+
+provider "echo" {
+  data = ephemeral.cratedb_cluster_connection.test
+}
+
+resource "echo" "test" {}
+`, clusterID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("echo.test", "data.host"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.username"),
+					resource.TestCheckResourceAttr("echo.test", "data.psql_port", "5432"),
+					resource.TestCheckResourceAttr("echo.test", "data.http_port", "4200"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.token"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.token_expiry"),
+					resource.TestMatchResourceAttr("echo.test", "data.psql_url", regexp.MustCompile(`^postgresql://[^:]+:not-the-real-password@`)),
+				),
+			},
+		},
+	})
+}
@@ -3,41 +3,48 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/thulasirajkomminar/cratedb-cloud-go"
 )
 
 // ClusterModel maps CrateDB cluster schema data.
 type ClusterModel struct {
-	OrganizationId     types.String              `tfsdk:"organization_id"`
-	AllowCustomStorage types.Bool                `tfsdk:"allow_custom_storage"`
-	AllowSuspend       types.Bool                `tfsdk:"allow_suspend"`
-	BackupSchedule     types.String              `tfsdk:"backup_schedule"`
-	Channel            types.String              `tfsdk:"channel"`
-	CrateVersion       types.String              `tfsdk:"crate_version"`
-	Dc                 types.Object              `tfsdk:"dc"`
-	DeletionProtected  types.Bool                `tfsdk:"deletion_protected"`
-	ExternalIp         types.String              `tfsdk:"external_ip"`
-	Fqdn               types.String              `tfsdk:"fqdn"`
-	GcAvailable        types.Bool                `tfsdk:"gc_available"`
-	HardwareSpecs      types.Object              `tfsdk:"hardware_specs"`
-	Health             types.Object              `tfsdk:"health"`
-	Id                 types.String              `tfsdk:"id"`
-	IpWhitelist        []ClusterIpWhitelistModel `tfsdk:"ip_whitelist"`
-	Name               types.String              `tfsdk:"name"`
-	NumNodes           types.Int32               `tfsdk:"num_nodes"`
-	Origin             types.String              `tfsdk:"origin"`
-	ProductName        types.String              `tfsdk:"product_name"`
-	ProductTier        types.String              `tfsdk:"product_tier"`
-	ProductUnit        types.Int32               `tfsdk:"product_unit"`
-	ProjectId          types.String              `tfsdk:"project_id"`
-	SubscriptionId     types.String              `tfsdk:"subscription_id"`
-	Suspended          types.Bool                `tfsdk:"suspended"`
-	Url                types.String              `tfsdk:"url"`
-	Username           types.String              `tfsdk:"username"`
-	Password           types.String              `tfsdk:"password"`
+	OrganizationId     types.String   `tfsdk:"organization_id"`
+	AllowCustomStorage types.Bool     `tfsdk:"allow_custom_storage"`
+	AllowSuspend       types.Bool     `tfsdk:"allow_suspend"`
+	BackupSchedule     types.String   `tfsdk:"backup_schedule"`
+	Channel            types.String   `tfsdk:"channel"`
+	CrateVersion       types.String   `tfsdk:"crate_version"`
+	Dc                 types.Object   `tfsdk:"dc"`
+	DeletionProtected  types.Bool     `tfsdk:"deletion_protected"`
+	ExternalIp         types.String   `tfsdk:"external_ip"`
+	Fqdn               types.String   `tfsdk:"fqdn"`
+	GcAvailable        types.Bool     `tfsdk:"gc_available"`
+	HardwareSpecs      types.Object   `tfsdk:"hardware_specs"`
+	Health             types.Object   `tfsdk:"health"`
+	Id                 types.String   `tfsdk:"id"`
+	IpWhitelist        types.Set      `tfsdk:"ip_whitelist"`
+	Name               types.String   `tfsdk:"name"`
+	NumNodes           types.Int32    `tfsdk:"num_nodes"`
+	Origin             types.String   `tfsdk:"origin"`
+	ProductName        types.String   `tfsdk:"product_name"`
+	ProductTier        types.String   `tfsdk:"product_tier"`
+	ProductUnit        types.Int32    `tfsdk:"product_unit"`
+	ProjectId          types.String   `tfsdk:"project_id"`
+	SubscriptionId     types.String   `tfsdk:"subscription_id"`
+	Suspended          types.Bool     `tfsdk:"suspended"`
+	Url                types.String   `tfsdk:"url"`
+	Username           types.String   `tfsdk:"username"`
+	Password           types.String   `tfsdk:"password"`
+	PasswordWo         types.String   `tfsdk:"password_wo"`
+	PasswordWoVersion  types.Int64    `tfsdk:"password_wo_version"`
+	RestoreFrom        types.Object   `tfsdk:"restore_from"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 // ClusterHardwareSpecsModel maps CrateDB cluster HardwareSpecs schema data.
@@ -72,6 +79,23 @@ func (c ClusterHealthModel) GetAttrType() map[string]attr.Type {
 	}
 }
 
+// ClusterRestoreFromModel maps the snapshot restored into a new cluster.
+type ClusterRestoreFromModel struct {
+	ClusterId  types.String `tfsdk:"cluster_id"`
+	Repository types.String `tfsdk:"repository"`
+	Snapshot   types.String `tfsdk:"snapshot"`
+	Tables     types.List   `tfsdk:"tables"`
+}
+
+func (c ClusterRestoreFromModel) GetAttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"cluster_id": types.StringType,
+		"repository": types.StringType,
+		"snapshot":   types.StringType,
+		"tables":     types.ListType{ElemType: types.StringType},
+	}
+}
+
 // ClusterIpWhitelistModel maps CrateDB cluster IpWhitelist schema data.
 type ClusterIpWhitelistModel struct {
 	Cidr        types.String `tfsdk:"cidr"`
@@ -85,6 +109,51 @@ func (c ClusterIpWhitelistModel) GetAttrType() attr.Type {
 	}}
 }
 
+// getClusterHardwareSpecs converts the configured hardware specs to the API
+// request body. It returns nil when no hardware specs are configured.
+func getClusterHardwareSpecs(ctx context.Context, hardwareSpecsObject types.Object) (*cratedb.HardwareSpecs, error) {
+	if hardwareSpecsObject.IsNull() || hardwareSpecsObject.IsUnknown() {
+		return nil, nil
+	}
+
+	var hardwareSpecsValue ClusterHardwareSpecsModel
+	if diags := hardwareSpecsObject.As(ctx, &hardwareSpecsValue, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return nil, fmt.Errorf("error getting cluster hardware specs: %v", diags.Errors())
+	}
+
+	var cpusPerNode *float32
+	if !hardwareSpecsValue.CpusPerNode.IsNull() && !hardwareSpecsValue.CpusPerNode.IsUnknown() {
+		cpus := float32(hardwareSpecsValue.CpusPerNode.ValueInt32())
+		cpusPerNode = &cpus
+	}
+
+	diskType := hardwareSpecsValue.DiskType.ValueStringPointer()
+	if hardwareSpecsValue.DiskType.IsUnknown() {
+		diskType = nil
+	}
+
+	return &cratedb.HardwareSpecs{
+		CpusPerNode:          cpusPerNode,
+		DiskSizePerNodeBytes: int64ValueToIntPointer(hardwareSpecsValue.DiskSizePerNodeBytes),
+		DiskType:             diskType,
+		DisksPerNode:         int32ValueToIntPointer(hardwareSpecsValue.DisksPerNode),
+		HeapSizeBytes:        int64ValueToIntPointer(hardwareSpecsValue.HeapSizeBytes),
+		MemoryPerNodeBytes:   int64ValueToIntPointer(hardwareSpecsValue.MemoryPerNodeBytes),
+	}, nil
+}
+
+// getClusterDiskSizePerNodeBytes returns the disk size of the hardware specs,
+// or an unknown value when the hardware specs are unknown.
+func getClusterDiskSizePerNodeBytes(hardwareSpecsObject types.Object) types.Int64 {
+	if hardwareSpecsObject.IsUnknown() {
+		return types.Int64Unknown()
+	}
+	if diskSize, ok := hardwareSpecsObject.Attributes()["disk_size_per_node_bytes"].(types.Int64); ok {
+		return diskSize
+	}
+	return types.Int64Null()
+}
+
 func getClusterModel(ctx context.Context, cluster cratedb.Cluster) (*ClusterModel, error) {
 	dcObjectValue, err := getDCObjectValue(ctx, cluster.Dc)
 	if err != nil {
@@ -129,14 +198,21 @@ func getClusterModel(ctx context.Context, cluster cratedb.Cluster) (*ClusterMode
 		healthObjectValue = healthObject
 	}
 
-	var ipWhitelistValues []ClusterIpWhitelistModel
+	ipWhitelistSetValue := types.SetNull(ClusterIpWhitelistModel{}.GetAttrType())
 	if cluster.IpWhitelist != nil {
+		ipWhitelistValues := make([]ClusterIpWhitelistModel, 0, len(*cluster.IpWhitelist))
 		for _, ipWhitelist := range *cluster.IpWhitelist {
 			ipWhitelistValues = append(ipWhitelistValues, ClusterIpWhitelistModel{
 				Cidr:        types.StringValue(ipWhitelist.Cidr),
 				Description: types.StringPointerValue(ipWhitelist.Description),
 			})
 		}
+
+		ipWhitelistSet, diags := types.SetValueFrom(ctx, ClusterIpWhitelistModel{}.GetAttrType(), ipWhitelistValues)
+		if diags.HasError() {
+			return nil, fmt.Errorf("error getting cluster IP whitelist value: %v", diags.Errors())
+		}
+		ipWhitelistSetValue = ipWhitelistSet
 	}
 
 	return &ClusterModel{
@@ -144,7 +220,7 @@ func getClusterModel(ctx context.Context, cluster cratedb.Cluster) (*ClusterMode
 		HardwareSpecs:      hardwareSpecsObjectValue,
 		Health:             healthObjectValue,
 		Id:                 types.StringPointerValue(cluster.Id),
-		IpWhitelist:        ipWhitelistValues,
+		IpWhitelist:        ipWhitelistSetValue,
 		AllowCustomStorage: types.BoolPointerValue(cluster.AllowCustomStorage),
 		AllowSuspend:       types.BoolPointerValue(cluster.AllowSuspend),
 		BackupSchedule:     types.StringPointerValue(cluster.BackupSchedule),
@@ -161,9 +237,58 @@ func getClusterModel(ctx context.Context, cluster cratedb.Cluster) (*ClusterMode
 		ProductTier:        types.StringValue(cluster.ProductTier),
 		ProductUnit:        intPointerToInt32Value(cluster.ProductUnit),
 		ProjectId:          types.StringValue(cluster.ProjectId),
+		RestoreFrom:        types.ObjectNull(ClusterRestoreFromModel{}.GetAttrType()),
 		SubscriptionId:     types.StringPointerValue(cluster.SubscriptionId),
 		Suspended:          types.BoolPointerValue(cluster.Suspended),
 		Url:                types.StringPointerValue(cluster.Url),
 		Username:           types.StringValue(cluster.Username),
 	}, nil
 }
+
+// getClusterIpWhitelist converts the ip_whitelist set to the API request body.
+func getClusterIpWhitelist(ctx context.Context, ipWhitelistSet types.Set) ([]cratedb.ClusterIpWhitelist, error) {
+	var ipWhitelistValues []ClusterIpWhitelistModel
+	if diags := ipWhitelistSet.ElementsAs(ctx, &ipWhitelistValues, false); diags.HasError() {
+		return nil, fmt.Errorf("error getting cluster IP whitelist: %v", diags.Errors())
+	}
+
+	ipWhitelist := make([]cratedb.ClusterIpWhitelist, 0, len(ipWhitelistValues))
+	for _, ipWhitelistValue := range ipWhitelistValues {
+		ipWhitelist = append(ipWhitelist, cratedb.ClusterIpWhitelist{
+			Cidr:        ipWhitelistValue.Cidr.ValueString(),
+			Description: ipWhitelistValue.Description.ValueStringPointer(),
+		})
+	}
+	return ipWhitelist, nil
+}
+
+// preserveEmptySet returns prior when neither set holds an element. The API
+// does not distinguish an empty list from a missing one, so this keeps a
+// configured empty set from showing up as drift against null, and vice versa.
+func preserveEmptySet(current, prior types.Set) types.Set {
+	if prior.IsUnknown() || len(current.Elements()) > 0 || len(prior.Elements()) > 0 {
+		return current
+	}
+	return prior
+}
+
+// sameBackupHours reports whether two backup schedules run at the same hours
+// of the day. Schedules that cannot be parsed are never the same.
+func sameBackupHours(a, b string) bool {
+	aHours, aErr := parseBackupSchedule(a)
+	bHours, bErr := parseBackupSchedule(b)
+	return aErr == nil && bErr == nil && slices.Equal(aHours, bHours)
+}
+
+// preserveBackupSchedule returns prior when both schedules run at the same
+// hours. Only the backup hours can be set through the API, so differences in
+// the other fields, such as the minute, are not drift.
+func preserveBackupSchedule(current, prior types.String) types.String {
+	if current.IsNull() || current.IsUnknown() || prior.IsNull() || prior.IsUnknown() {
+		return current
+	}
+	if sameBackupHours(current.ValueString(), prior.ValueString()) {
+		return prior
+	}
+	return current
+}
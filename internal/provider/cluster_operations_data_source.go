@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ClusterOperationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ClusterOperationsDataSource{}
+)
+
+// NewClusterOperationsDataSource is a helper function to simplify the provider implementation.
+func NewClusterOperationsDataSource() datasource.DataSource {
+	return &ClusterOperationsDataSource{}
+}
+
+// ClusterOperationsDataSource is the data source implementation.
+type ClusterOperationsDataSource struct {
+	client *cratedb.ClientWithResponses
+}
+
+// ClusterOperationsDataSourceModel describes the data source data model.
+type ClusterOperationsDataSourceModel struct {
+	ClusterId  types.String            `tfsdk:"cluster_id"`
+	Type       types.String            `tfsdk:"type"`
+	Status     types.String            `tfsdk:"status"`
+	Operations []ClusterOperationModel `tfsdk:"operations"`
+}
+
+// ClusterOperationModel maps CrateDB cluster async operation schema data.
+type ClusterOperationModel struct {
+	Feedback types.String      `tfsdk:"feedback"`
+	Finished timetypes.RFC3339 `tfsdk:"finished"`
+	Id       types.String      `tfsdk:"id"`
+	Started  timetypes.RFC3339 `tfsdk:"started"`
+	Status   types.String      `tfsdk:"status"`
+	Type     types.String      `tfsdk:"type"`
+}
+
+// Metadata returns the data source type name.
+func (d *ClusterOperationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_operations"
+}
+
+// Schema defines the schema for the data source.
+func (d *ClusterOperationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "To retrieve the async operations of a cluster, such as upgrades, scaling or snapshot restores, optionally filtered. Useful to find out why a cluster is stuck.",
+
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the cluster.",
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return operations of this type, e.g. `CREATE`, `UPGRADE`, `SCALE` or `RESTORE_SNAPSHOT`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return operations with this status, e.g. `IN_PROGRESS`, `SUCCEEDED` or `FAILED`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"operations": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The list of operations.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"feedback": schema.StringAttribute{
+							Computed:    true,
+							Description: "The error message the API reported for the operation, or its raw feedback data when it has no message.",
+						},
+						"finished": schema.StringAttribute{
+							CustomType:  timetypes.RFC3339Type{},
+							Computed:    true,
+							Description: "The time the operation succeeded or failed. Not set while it is running.",
+						},
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The id of the operation.",
+						},
+						"started": schema.StringAttribute{
+							CustomType:  timetypes.RFC3339Type{},
+							Computed:    true,
+							Description: "The time the operation was started.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the operation.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the operation.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ClusterOperationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := clientFromProviderData(req.ProviderData, "Data Source", &resp.Diagnostics); client != nil {
+		d.client = client
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ClusterOperationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ClusterOperationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The status filter is applied by the API.
+	operations, err := listClusterOperations(ctx, d.client, state.ClusterId.ValueString(), &cratedb.GetApiV2ClustersClusterIdOperationsParams{
+		Statuses: state.Status.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting cluster operations",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Operations = []ClusterOperationModel{}
+	for _, operation := range operations {
+		if !state.Type.IsNull() && (operation.Type == nil || *operation.Type != state.Type.ValueString()) {
+			continue
+		}
+		state.Operations = append(state.Operations, getClusterOperationModel(operation))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func getClusterOperationModel(operation apiClusterOperation) ClusterOperationModel {
+	started := timetypes.NewRFC3339Null()
+	finished := timetypes.NewRFC3339Null()
+	if operation.Dc != nil {
+		if operation.Dc.Created != nil {
+			started = timetypes.NewRFC3339TimeValue(operation.Dc.Created.Time)
+		}
+		// A finished operation is not modified any more.
+		if operation.Dc.Modified != nil && operation.Status != nil &&
+			(*operation.Status == clusterOperationSucceeded || *operation.Status == clusterOperationFailed) {
+			finished = timetypes.NewRFC3339TimeValue(operation.Dc.Modified.Time)
+		}
+	}
+
+	feedback := types.StringNull()
+	if message := operation.feedback(); message != "" {
+		feedback = types.StringValue(message)
+	}
+
+	return ClusterOperationModel{
+		Feedback: feedback,
+		Finished: finished,
+		Id:       types.StringPointerValue(operation.Id),
+		Started:  started,
+		Status:   types.StringPointerValue(operation.Status),
+		Type:     types.StringPointerValue(operation.Type),
+	}
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestGetClusterOperationModel(t *testing.T) {
+	// Operation timestamps are returned without a timezone offset by the
+	// live API.
+	payload := `{"operations": [
+		{
+			"id": "op-2",
+			"type": "UPGRADE",
+			"status": "IN_PROGRESS",
+			"dc": {"created": "2026-07-10T10:41:02.983000", "modified": "2026-07-10T10:42:00.000000"}
+		},
+		{
+			"id": "op-1",
+			"type": "SCALE",
+			"status": "FAILED",
+			"feedback_data": {"message": "Not enough capacity."},
+			"dc": {"created": "2026-07-09T10:00:00", "modified": "2026-07-09T10:05:00"}
+		}
+	]}`
+
+	var operations apiClusterOperationsList
+	if err := json.Unmarshal([]byte(payload), &operations); err != nil {
+		t.Fatalf("unmarshalling operations payload: %v", err)
+	}
+
+	running := getClusterOperationModel(operations.Operations[0])
+	started, diags := running.Started.ValueRFC3339Time()
+	if diags.HasError() || !started.Equal(time.Date(2026, 7, 10, 10, 41, 2, 0, time.UTC)) {
+		t.Errorf("unexpected started time %s", running.Started.ValueString())
+	}
+	if !running.Finished.IsNull() {
+		t.Errorf("expected no finished time for a running operation, got %s", running.Finished.ValueString())
+	}
+	if !running.Feedback.IsNull() {
+		t.Errorf("expected no feedback, got %s", running.Feedback.ValueString())
+	}
+
+	failed := getClusterOperationModel(operations.Operations[1])
+	finished, diags := failed.Finished.ValueRFC3339Time()
+	if diags.HasError() || !finished.Equal(time.Date(2026, 7, 9, 10, 5, 0, 0, time.UTC)) {
+		t.Errorf("unexpected finished time %s", failed.Finished.ValueString())
+	}
+	if failed.Feedback.ValueString() != "Not enough capacity." {
+		t.Errorf("unexpected feedback %q", failed.Feedback.ValueString())
+	}
+	if failed.Type.ValueString() != "SCALE" || failed.Status.ValueString() != "FAILED" {
+		t.Errorf("unexpected type %s or status %s", failed.Type.ValueString(), failed.Status.ValueString())
+	}
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccClusterOperationsDataSource lists the operations of an existing
+// cluster. Every cluster has at least its CREATE operation.
+func TestAccClusterOperationsDataSource(t *testing.T) {
+	clusterID := envOrSkip(t, "CRATEDB_CLUSTER_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + fmt.Sprintf(`
+data "cratedb_cluster_operations" "test" {
+  cluster_id = %q
+  type       = "CREATE"
+}
+`, clusterID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cratedb_cluster_operations.test", "cluster_id", clusterID),
+					resource.TestCheckResourceAttr("data.cratedb_cluster_operations.test", "operations.0.type", "CREATE"),
+					resource.TestCheckResourceAttrSet("data.cratedb_cluster_operations.test", "operations.0.id"),
+					resource.TestCheckResourceAttrSet("data.cratedb_cluster_operations.test", "operations.0.started"),
+				),
+			},
+		},
+	})
+}
@@ -1,24 +1,39 @@
 package provider
 
 import (
+	"cmp"
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
 	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/thulasirajkomminar/cratedb-cloud-go"
 )
 
@@ -27,6 +42,14 @@ var (
 	_ resource.Resource                = &ClusterResource{}
 	_ resource.ResourceWithConfigure   = &ClusterResource{}
 	_ resource.ResourceWithImportState = &ClusterResource{}
+	_ resource.ResourceWithModifyPlan  = &ClusterResource{}
+)
+
+// Default bounds for how long Create and Update wait for the cluster's async
+// operations to finish when no timeouts are configured.
+const (
+	defaultClusterCreateTimeout = 60 * time.Minute
+	defaultClusterUpdateTimeout = 60 * time.Minute
 )
 
 // NewClusterResource is a helper function to simplify the provider implementation.
@@ -74,20 +97,27 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 			},
 			"backup_schedule": schema.StringAttribute{
 				Computed:    true,
-				Description: "The backup schedule.",
+				Optional:    true,
+				Description: "The backup schedule as a cron expression of the form `<minute> <hours> * * *`, e.g. `0 3,15 * * *`. Backups are scheduled by hour of the day, so only the hours are applied and the minute is chosen by CrateDB Cloud.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					backupScheduleValidator{},
+				},
 			},
 			"channel": schema.StringAttribute{
 				Computed:    true,
 				Optional:    true,
 				Default:     stringdefault.StaticString("stable"),
-				Description: "The channel of the cluster. Default is `stable`.",
+				Description: "The channel of the cluster, one of `stable`, `testing` or `nightly`. Default is `stable`. The channel of an existing cluster cannot be changed, and `crate_version` can only be upgraded to versions of its channel.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("stable", "testing", "nightly"),
+				},
 			},
 			"crate_version": schema.StringAttribute{
 				Required:    true,
-				Description: "The CrateDB version of the cluster.",
+				Description: "The CrateDB version of the cluster. Changing it upgrades the cluster in place within its channel. Downgrades are not supported.",
 			},
 			"dc": schema.SingleNestedAttribute{
 				Computed:    true,
@@ -116,7 +146,8 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 			},
 			"deletion_protected": schema.BoolAttribute{
 				Computed:    true,
-				Description: "The deletion protected flag.",
+				Optional:    true,
+				Description: "The deletion protected flag. While it is `true`, the provider refuses to destroy or replace the cluster; set it to `false` and apply before doing so.",
 				PlanModifiers: []planmodifier.Bool{
 					boolplanmodifier.UseStateForUnknown(),
 				},
@@ -145,7 +176,7 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"hardware_specs": schema.SingleNestedAttribute{
 				Computed:    true,
 				Optional:    true,
-				Description: "The hardware specs of the cluster.",
+				Description: "The hardware specs of the cluster. Values that are not configured are determined by the product. Increasing `disk_size_per_node_bytes` expands the storage in place, which requires `allow_custom_storage`; shrinking it is not supported. Changing any other configured value forces a new cluster.",
 				PlanModifiers: []planmodifier.Object{
 					objectplanmodifier.UseStateForUnknown(),
 				},
@@ -154,31 +185,54 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 						Computed:    true,
 						Optional:    true,
 						Description: "The cpus per node.",
+						PlanModifiers: []planmodifier.Int32{
+							int32planmodifier.UseStateForUnknown(),
+							int32planmodifier.RequiresReplaceIfConfigured(),
+						},
 					},
 					"disk_size_per_node_bytes": schema.Int64Attribute{
 						Computed:    true,
 						Optional:    true,
 						Description: "The disk size per node in bytes.",
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
 					},
 					"disk_type": schema.StringAttribute{
 						Computed:    true,
 						Optional:    true,
 						Description: "The disk type.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplaceIfConfigured(),
+						},
 					},
 					"disks_per_node": schema.Int32Attribute{
 						Computed:    true,
 						Optional:    true,
 						Description: "The disks per node.",
+						PlanModifiers: []planmodifier.Int32{
+							int32planmodifier.UseStateForUnknown(),
+							int32planmodifier.RequiresReplaceIfConfigured(),
+						},
 					},
 					"heap_size_bytes": schema.Int64Attribute{
 						Computed:    true,
 						Optional:    true,
 						Description: "The heap size in bytes.",
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+							int64planmodifier.RequiresReplaceIfConfigured(),
+						},
 					},
 					"memory_per_node_bytes": schema.Int64Attribute{
 						Computed:    true,
 						Optional:    true,
 						Description: "The memory per node in bytes.",
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+							int64planmodifier.RequiresReplaceIfConfigured(),
+						},
 					},
 				},
 			},
@@ -207,23 +261,23 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 			},
 			"ip_whitelist": schema.SetNestedAttribute{
 				Computed:    true,
-				Description: "The IP whitelist of the cluster.",
-				Default:     setdefault.StaticValue(types.SetNull(ClusterIpWhitelistModel{}.GetAttrType())),
+				Optional:    true,
+				Description: "The IP whitelist of the cluster. When set, only the listed networks can connect to the cluster; an empty set allows all networks. When omitted, the whitelist is left as is.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"cidr": schema.StringAttribute{
-							Computed:    true,
+							Required:    true,
 							Description: "The CIDR.",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
+							Validators: []validator.String{
+								cidrValidator{},
 							},
 						},
 						"description": schema.StringAttribute{
-							Computed:    true,
+							Optional:    true,
 							Description: "The description.",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
 						},
 					},
 				},
@@ -234,10 +288,15 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 			},
 			"num_nodes": schema.Int32Attribute{
 				Computed:    true,
-				Description: "The number of nodes in the cluster.",
+				Optional:    true,
+				Description: "The number of nodes in the cluster. Changing it scales the cluster in place. Conflicts with `product_unit`.",
 				PlanModifiers: []planmodifier.Int32{
 					int32planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+					int32validator.ConflictsWith(path.MatchRoot("product_unit")),
+				},
 			},
 			"origin": schema.StringAttribute{
 				Computed:    true,
@@ -248,7 +307,7 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 			},
 			"product_name": schema.StringAttribute{
 				Required:    true,
-				Description: "The product name of the cluster.",
+				Description: "The product name of the cluster. Changing it changes the product of the cluster in place.",
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 					stringvalidator.LengthAtMost(512),
@@ -260,7 +319,10 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 			},
 			"product_tier": schema.StringAttribute{
 				Required:    true,
-				Description: "The product tier of the cluster.",
+				Description: "The product tier of the cluster. Changing it forces a new cluster.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 					stringvalidator.LengthAtMost(512),
@@ -273,8 +335,13 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"product_unit": schema.Int32Attribute{
 				Computed:    true,
 				Optional:    true,
-				Default:     int32default.StaticInt32(0),
-				Description: "The product unit of the cluster. Default is `0`.",
+				Description: "The product unit of the cluster, one less than the number of nodes. Changing it scales the cluster in place. Default is `0` unless `num_nodes` is set. Conflicts with `num_nodes`.",
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
 			},
 			"project_id": schema.StringAttribute{
 				Required:    true,
@@ -298,7 +365,8 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 			},
 			"suspended": schema.BoolAttribute{
 				Computed:    true,
-				Description: "The suspended flag.",
+				Optional:    true,
+				Description: "The suspended flag. Setting it suspends or resumes the cluster, which requires `allow_suspend`.",
 				PlanModifiers: []planmodifier.Bool{
 					boolplanmodifier.UseStateForUnknown(),
 				},
@@ -315,16 +383,69 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Description: "The username of the cluster.",
 			},
 			"password": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
-				Description: "The password of the cluster.",
+				Description: "The password of the cluster. It is stored in the Terraform state, use `password_wo` instead on Terraform 1.11 and later. Exactly one of `password` or `password_wo` must be set.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(24),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("password_wo")),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "The write-only password of the cluster, which is never stored in the Terraform state. Requires Terraform 1.11 or later. Change `password_wo_version` to apply a new password.",
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(24),
 				},
 			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The version of `password_wo`. Changing it updates the cluster password with the current `password_wo` value.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
+			},
+			"restore_from": schema.SingleNestedAttribute{
+				Optional: true,
+				Description: "Restores a snapshot of another cluster into the new cluster once it is deployed, for example to clone a production cluster into a staging project. The snapshots of a cluster are listed by the `cratedb_cluster_snapshots` data source. Changing it forces a new cluster. " +
+					"Like `hardware_specs` it is a nested attribute rather than a block, so it is set with `restore_from = { ... }` and can be assigned from a variable or set to `null`.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"cluster_id": schema.StringAttribute{
+						Required:    true,
+						Description: "The id of the cluster the snapshot was taken of.",
+					},
+					"repository": schema.StringAttribute{
+						Optional:    true,
+						Description: "The repository of the snapshot. Defaults to the repository the snapshot is listed with.",
+					},
+					"snapshot": schema.StringAttribute{
+						Optional:    true,
+						Description: "The name of the snapshot. Defaults to the latest snapshot of the cluster at the time the new cluster is created.",
+					},
+					"tables": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "The tables to restore. All tables are restored when omitted.",
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
 		},
 	}
 }
@@ -339,19 +460,51 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultClusterCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Generate API request body from plan
-	productUnit := int(plan.ProductUnit.ValueInt32())
+	hardwareSpecs, err := getClusterHardwareSpecs(ctx, plan.HardwareSpecs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating cluster",
+			"Could not create cluster, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	productUnit := clusterProductUnit(plan)
 	password := plan.Password
+	passwordWoVersion := plan.PasswordWoVersion
 	organizationId := plan.OrganizationId
+	clusterTimeouts := plan.Timeouts
+	restoreFrom := plan.RestoreFrom
+	ipWhitelist := plan.IpWhitelist
+	suspended := plan.Suspended
+	deletionProtected := plan.DeletionProtected
+	backupSchedule := plan.BackupSchedule
+
+	// The write-only password is only available in the configuration
+	clusterPassword := password
+	if clusterPassword.IsNull() {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &clusterPassword)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	createPartialClusterRequest := cratedb.PartialCluster{
-		Channel:      plan.Channel.ValueStringPointer(),
-		CrateVersion: plan.CrateVersion.ValueString(),
-		Name:         plan.Name.ValueString(),
-		ProductName:  plan.ProductName.ValueString(),
-		ProductTier:  plan.ProductTier.ValueString(),
-		ProductUnit:  &productUnit,
-		Username:     plan.Username.ValueString(),
-		Password:     password.ValueStringPointer(),
+		Channel:       plan.Channel.ValueStringPointer(),
+		CrateVersion:  plan.CrateVersion.ValueString(),
+		HardwareSpecs: hardwareSpecs,
+		Name:          plan.Name.ValueString(),
+		ProductName:   plan.ProductName.ValueString(),
+		ProductTier:   plan.ProductTier.ValueString(),
+		ProductUnit:   &productUnit,
+		Username:      plan.Username.ValueString(),
+		Password:      clusterPassword.ValueStringPointer(),
 	}
 
 	createClusterRequest := cratedb.ClusterProvision{
@@ -389,6 +542,104 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 	plan = *clusterPlan
 	plan.OrganizationId = organizationId
 	plan.Password = password
+	plan.PasswordWoVersion = passwordWoVersion
+	plan.Timeouts = clusterTimeouts
+	plan.RestoreFrom = restoreFrom
+
+	// Save the cluster into Terraform state before waiting, so a failed or
+	// timed out deployment leaves a tainted resource instead of an orphan.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Wait for the cluster deployment to finish
+	cluster, err := waitForClusterOperation(ctx, r.client, plan.Id.ValueString(), cratedb.CREATE, "", createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for cluster deployment",
+			"Could not deploy cluster, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Restore the snapshot before anything else changes the cluster
+	if !restoreFrom.IsUnknown() && !restoreFrom.IsNull() {
+		cluster, err = r.restoreClusterSnapshot(ctx, plan.Id.ValueString(), cluster, restoreFrom, createTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error restoring cluster snapshot",
+				"Could not restore cluster snapshot, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Restrict access to the cluster
+	if !ipWhitelist.IsUnknown() && !ipWhitelist.IsNull() {
+		cluster, err = r.updateClusterIpWhitelist(ctx, plan.Id.ValueString(), cluster, ipWhitelist, createTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating cluster IP whitelist",
+				"Could not update cluster IP whitelist, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Schedule the cluster backups
+	if !backupSchedule.IsUnknown() && !backupSchedule.IsNull() && !sameBackupHours(backupSchedule.ValueString(), types.StringPointerValue(cluster.BackupSchedule).ValueString()) {
+		cluster, err = r.updateClusterBackupSchedule(ctx, plan.Id.ValueString(), cluster, backupSchedule.ValueString(), createTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating cluster backup schedule",
+				"Could not update cluster backup schedule, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Protect the cluster from deletion
+	if deletionProtected.ValueBool() {
+		cluster, err = r.updateClusterDeletionProtection(ctx, plan.Id.ValueString(), true)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating cluster deletion protection",
+				"Could not update cluster deletion protection, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Suspend the cluster last, as a suspended cluster cannot be changed
+	if suspended.ValueBool() {
+		cluster, err = r.suspendCluster(ctx, plan.Id.ValueString(), cluster, true, createTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error suspending cluster",
+				"Could not suspend cluster, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Map the deployed cluster to schema
+	clusterPlan, err = getClusterModel(ctx, *cluster)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting cluster model",
+			err.Error(),
+		)
+		return
+	}
+	plan = *clusterPlan
+	plan.OrganizationId = organizationId
+	plan.Password = password
+	plan.PasswordWoVersion = passwordWoVersion
+	plan.Timeouts = clusterTimeouts
+	plan.RestoreFrom = restoreFrom
+	plan.IpWhitelist = preserveEmptySet(plan.IpWhitelist, ipWhitelist)
+	plan.BackupSchedule = preserveBackupSchedule(plan.BackupSchedule, backupSchedule)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -410,7 +661,12 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	// Get refreshed cluster value from API
 	password := state.Password
+	passwordWoVersion := state.PasswordWoVersion
 	organizationId := state.OrganizationId
+	clusterTimeouts := state.Timeouts
+	restoreFrom := state.RestoreFrom
+	ipWhitelist := state.IpWhitelist
+	backupSchedule := state.BackupSchedule
 	readClusterResponse, err := r.client.GetApiV2ClustersClusterIdWithResponse(ctx, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -448,6 +704,11 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 	state = *clusterState
 	state.OrganizationId = organizationId
 	state.Password = password
+	state.PasswordWoVersion = passwordWoVersion
+	state.Timeouts = clusterTimeouts
+	state.RestoreFrom = restoreFrom
+	state.IpWhitelist = preserveEmptySet(state.IpWhitelist, ipWhitelist)
+	state.BackupSchedule = preserveBackupSchedule(state.BackupSchedule, backupSchedule)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -458,41 +719,188 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan ClusterModel
+	var plan, state ClusterModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Generate API request body from plan
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultClusterUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterId := state.Id.ValueString()
 	password := plan.Password
+	passwordWoVersion := plan.PasswordWoVersion
 	organizationId := plan.OrganizationId
-	updateClusterRequest := cratedb.ClusterEdit{
-		Password: plan.Password.ValueStringPointer(),
-	}
+	clusterTimeouts := plan.Timeouts
+	restoreFrom := plan.RestoreFrom
+	ipWhitelist := plan.IpWhitelist
+	backupSchedule := plan.BackupSchedule
 
-	// Update existing cluster
-	updateClusterResponse, err := r.client.PatchApiV2ClustersClusterIdWithResponse(ctx, plan.Id.ValueString(), updateClusterRequest)
+	// Get the current cluster, so that waiting for an operation can tell it
+	// apart from the operations that ran before
+	cluster, err := getCluster(ctx, r.client, clusterId)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating cluster",
-			"Could not update cluster, unexpected error: "+err.Error(),
+			"Could not read cluster, unexpected error: "+err.Error(),
 		)
 		return
 	}
 
-	if updateClusterResponse.StatusCode() != 200 || updateClusterResponse.JSON200 == nil {
-		resp.Diagnostics.AddError(
-			"Error updating cluster",
-			apiErrorDetail(updateClusterResponse.HTTPResponse, updateClusterResponse.Body),
-		)
-		return
+	// Resume the cluster first, as a suspended cluster cannot be changed
+	if !plan.Suspended.IsUnknown() && !plan.Suspended.ValueBool() && state.Suspended.ValueBool() {
+		cluster, err = r.suspendCluster(ctx, clusterId, cluster, false, updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error resuming cluster",
+				"Could not resume cluster, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Update the cluster deletion protection
+	if !plan.DeletionProtected.IsUnknown() && !plan.DeletionProtected.Equal(state.DeletionProtected) {
+		cluster, err = r.updateClusterDeletionProtection(ctx, clusterId, plan.DeletionProtected.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating cluster deletion protection",
+				"Could not update cluster deletion protection, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Update the cluster password. The write-only password is only available
+	// in the configuration and is applied when its version changes.
+	var newPassword types.String
+	switch {
+	case !plan.Password.IsNull() && !plan.Password.Equal(state.Password):
+		newPassword = plan.Password
+	case !plan.PasswordWoVersion.IsNull() && !plan.PasswordWoVersion.Equal(state.PasswordWoVersion):
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &newPassword)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if !newPassword.IsNull() {
+		updateClusterRequest := cratedb.ClusterEdit{
+			Password: newPassword.ValueStringPointer(),
+		}
+
+		updateClusterResponse, err := r.client.PatchApiV2ClustersClusterIdWithResponse(ctx, clusterId, updateClusterRequest)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating cluster",
+				"Could not update cluster, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		if updateClusterResponse.StatusCode() != 200 || updateClusterResponse.JSON200 == nil {
+			resp.Diagnostics.AddError(
+				"Error updating cluster",
+				apiErrorDetail(updateClusterResponse.HTTPResponse, updateClusterResponse.Body),
+			)
+			return
+		}
+		cluster = updateClusterResponse.JSON200
+	}
+
+	// Upgrade the cluster
+	if !plan.CrateVersion.Equal(state.CrateVersion) {
+		cluster, err = r.upgradeCluster(ctx, clusterId, cluster, plan.CrateVersion.ValueString(), updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error upgrading cluster",
+				"Could not upgrade cluster, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Update the cluster IP whitelist
+	if !plan.IpWhitelist.IsUnknown() && !plan.IpWhitelist.Equal(state.IpWhitelist) {
+		cluster, err = r.updateClusterIpWhitelist(ctx, clusterId, cluster, plan.IpWhitelist, updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating cluster IP whitelist",
+				"Could not update cluster IP whitelist, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Update the cluster backup schedule
+	if !plan.BackupSchedule.IsUnknown() && !plan.BackupSchedule.IsNull() && !sameBackupHours(plan.BackupSchedule.ValueString(), state.BackupSchedule.ValueString()) {
+		cluster, err = r.updateClusterBackupSchedule(ctx, clusterId, cluster, plan.BackupSchedule.ValueString(), updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating cluster backup schedule",
+				"Could not update cluster backup schedule, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Change the cluster product
+	if !plan.ProductName.Equal(state.ProductName) {
+		cluster, err = r.changeClusterProduct(ctx, clusterId, cluster, plan.ProductName.ValueString(), updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error changing cluster product",
+				"Could not change cluster product, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Expand the cluster storage
+	if diskSize := getClusterDiskSizePerNodeBytes(plan.HardwareSpecs); !diskSize.IsUnknown() && !diskSize.IsNull() &&
+		diskSize.ValueInt64() > getClusterDiskSizePerNodeBytes(state.HardwareSpecs).ValueInt64() {
+		cluster, err = r.expandClusterStorage(ctx, clusterId, cluster, diskSize.ValueInt64(), updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error expanding cluster storage",
+				"Could not expand cluster storage, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Scale the cluster
+	if productUnit := clusterProductUnit(plan); productUnit != int(state.ProductUnit.ValueInt32()) {
+		cluster, err = r.scaleCluster(ctx, clusterId, cluster, productUnit, updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error scaling cluster",
+				"Could not scale cluster, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Suspend the cluster last, as a suspended cluster cannot be changed
+	if plan.Suspended.ValueBool() && !state.Suspended.ValueBool() {
+		cluster, err = r.suspendCluster(ctx, clusterId, cluster, true, updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error suspending cluster",
+				"Could not suspend cluster, unexpected error: "+err.Error(),
+			)
+			return
+		}
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	clusterPlan, err := getClusterModel(ctx, *updateClusterResponse.JSON200)
+	clusterPlan, err := getClusterModel(ctx, *cluster)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting cluster model",
@@ -503,6 +911,11 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 	plan = *clusterPlan
 	plan.OrganizationId = organizationId
 	plan.Password = password
+	plan.PasswordWoVersion = passwordWoVersion
+	plan.Timeouts = clusterTimeouts
+	plan.RestoreFrom = restoreFrom
+	plan.IpWhitelist = preserveEmptySet(plan.IpWhitelist, ipWhitelist)
+	plan.BackupSchedule = preserveBackupSchedule(plan.BackupSchedule, backupSchedule)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -521,6 +934,15 @@ func (r *ClusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	// Never delete a protected cluster, even if the plan was not checked
+	if state.DeletionProtected.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Cluster is deletion protected",
+			clusterDeletionProtectedDetail(state.Name.ValueString()),
+		)
+		return
+	}
+
 	// Delete existing cluster
 	deleteClustersResponse, err := r.client.DeleteApiV2ClustersClusterIdWithResponse(ctx, state.Id.ValueString())
 	if err != nil {
@@ -553,8 +975,502 @@ func (r *ClusterResource) Configure(ctx context.Context, req resource.ConfigureR
 	}
 }
 
+// ModifyPlan marks the values that an in-place update will change as unknown.
+func (r *ClusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state ClusterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A protected cluster must neither be destroyed nor replaced.
+	if req.Plan.Raw.IsNull() {
+		if state.DeletionProtected.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Cluster is deletion protected",
+				clusterDeletionProtectedDetail(state.Name.ValueString()),
+			)
+		}
+		return
+	}
+
+	var plan ClusterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.DeletionProtected.ValueBool() {
+		requiresReplace, diags := clusterRequiresReplace(ctx, req)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if requiresReplace {
+			resp.Diagnostics.AddError(
+				"Cluster is deletion protected",
+				clusterDeletionProtectedDetail(state.Name.ValueString()),
+			)
+			return
+		}
+	}
+
+	// num_nodes and product_unit both size the cluster, so changing one of
+	// them changes the other once the cluster has been scaled.
+	if !plan.NumNodes.IsUnknown() && !plan.NumNodes.Equal(state.NumNodes) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("product_unit"), types.Int32Unknown())...)
+	}
+	if !plan.ProductUnit.IsUnknown() && !plan.ProductUnit.Equal(state.ProductUnit) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("num_nodes"), types.Int32Unknown())...)
+	}
+
+	// Changing the product changes the hardware and the features of the
+	// cluster, so everything that follows from the product becomes unknown.
+	if !plan.ProductName.IsUnknown() && !plan.ProductName.Equal(state.ProductName) {
+		var configHardwareSpecs types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("hardware_specs"), &configHardwareSpecs)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		hardwareSpecs, err := unknownUnlessConfigured(ctx, configHardwareSpecs, ClusterHardwareSpecsModel{}.GetAttrType())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error planning cluster hardware specs",
+				err.Error(),
+			)
+			return
+		}
+		plan.HardwareSpecs = hardwareSpecs
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("hardware_specs"), hardwareSpecs)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("allow_custom_storage"), types.BoolUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("allow_suspend"), types.BoolUnknown())...)
+	}
+
+	// Storage can only be expanded, and only on products that allow it.
+	plannedDiskSize := getClusterDiskSizePerNodeBytes(plan.HardwareSpecs)
+	stateDiskSize := getClusterDiskSizePerNodeBytes(state.HardwareSpecs)
+	if !plannedDiskSize.IsUnknown() && !plannedDiskSize.IsNull() && !stateDiskSize.IsNull() {
+		switch {
+		case plannedDiskSize.ValueInt64() < stateDiskSize.ValueInt64():
+			resp.Diagnostics.AddAttributeError(
+				path.Root("hardware_specs").AtName("disk_size_per_node_bytes"),
+				"Cluster storage cannot be shrunk",
+				fmt.Sprintf("The cluster has %d bytes of disk per node, which cannot be shrunk to %d bytes. Choose a size of at least %d bytes, or create a new cluster.",
+					stateDiskSize.ValueInt64(), plannedDiskSize.ValueInt64(), stateDiskSize.ValueInt64()),
+			)
+		case plannedDiskSize.ValueInt64() > stateDiskSize.ValueInt64() && !state.AllowCustomStorage.IsNull() && !state.AllowCustomStorage.ValueBool():
+			resp.Diagnostics.AddAttributeError(
+				path.Root("hardware_specs").AtName("disk_size_per_node_bytes"),
+				"Cluster storage cannot be expanded",
+				fmt.Sprintf("The product %q of the cluster does not allow custom storage (allow_custom_storage is false).",
+					state.ProductName.ValueString()),
+			)
+		}
+	}
+
+	// Only some products can be suspended.
+	if plan.Suspended.ValueBool() && !state.Suspended.ValueBool() && !state.AllowSuspend.IsUnknown() && !state.AllowSuspend.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("suspended"),
+			"Cluster cannot be suspended",
+			fmt.Sprintf("The product %q of the cluster does not allow suspending it (allow_suspend is false). Remove suspended = true, or change to a product that can be suspended.",
+				state.ProductName.ValueString()),
+		)
+	}
+
+	// Versions cannot be upgraded across channels, so the channel of a
+	// cluster is fixed.
+	if !plan.Channel.IsUnknown() && !plan.Channel.Equal(state.Channel) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("channel"),
+			"Cluster channel cannot be changed",
+			fmt.Sprintf("The cluster was deployed from the %s channel, which cannot be changed to %s. Keep channel = %q, or create a new cluster in the %s channel.",
+				state.Channel.ValueString(), plan.Channel.ValueString(), state.Channel.ValueString(), plan.Channel.ValueString()),
+		)
+		return
+	}
+
+	// Clusters can only be upgraded, never downgraded, and only to a version
+	// of their channel.
+	if !plan.CrateVersion.IsUnknown() && !plan.CrateVersion.Equal(state.CrateVersion) {
+		if comparison, ok := compareCrateVersions(plan.CrateVersion.ValueString(), state.CrateVersion.ValueString()); ok && comparison < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("crate_version"),
+				"Unsupported CrateDB version downgrade",
+				fmt.Sprintf("The cluster runs CrateDB %s and cannot be downgraded to %s. Choose a newer version, or create a new cluster to run an older one.",
+					state.CrateVersion.ValueString(), plan.CrateVersion.ValueString()),
+			)
+			return
+		}
+
+		// The provider is not configured yet while validating.
+		if r.client == nil {
+			return
+		}
+
+		channel := state.Channel.ValueString()
+		versions, err := listCrateVersions(ctx, r.client, channel)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error getting CrateDB versions",
+				err.Error(),
+			)
+			return
+		}
+		if !slices.ContainsFunc(versions, func(version apiCrateVersion) bool {
+			return version.Version != nil && *version.Version == plan.CrateVersion.ValueString()
+		}) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("crate_version"),
+				"CrateDB version not available",
+				fmt.Sprintf("CrateDB %s is not available in the %s channel of the cluster. The cratedb_crate_versions data source lists the versions of a channel.",
+					plan.CrateVersion.ValueString(), channel),
+			)
+		}
+	}
+}
+
 func (r *ClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Read refreshes the cluster by its id, so the import identifier is the
 	// cluster id.
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// scaleCluster scales a cluster to the given product unit and waits for the
+// scale operation to finish.
+func (r *ClusterResource) scaleCluster(ctx context.Context, clusterId string, cluster *cratedb.Cluster, productUnit int, timeout time.Duration) (*cratedb.Cluster, error) {
+	scaleClusterResponse, err := r.client.PutApiV2ClustersClusterIdScaleWithResponse(ctx, clusterId, cratedb.ClusterScale{
+		ProductUnit: productUnit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if scaleClusterResponse.StatusCode() != 200 || scaleClusterResponse.JSON200 == nil {
+		return nil, errors.New(apiErrorDetail(scaleClusterResponse.HTTPResponse, scaleClusterResponse.Body))
+	}
+
+	return waitForClusterOperation(ctx, r.client, clusterId, cratedb.SCALE, lastClusterOperationId(cluster), timeout)
+}
+
+// upgradeCluster upgrades a cluster to the given CrateDB version and waits for
+// the upgrade operation to finish. ModifyPlan checks that the version is
+// available in the cluster's channel.
+func (r *ClusterResource) upgradeCluster(ctx context.Context, clusterId string, cluster *cratedb.Cluster, crateVersion string, timeout time.Duration) (*cratedb.Cluster, error) {
+	upgradeClusterResponse, err := r.client.PutApiV2ClustersClusterIdUpgradeWithResponse(ctx, clusterId, cratedb.ClusterUpgrade{
+		CrateVersion: crateVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if upgradeClusterResponse.StatusCode() != 200 || upgradeClusterResponse.JSON200 == nil {
+		return nil, errors.New(apiErrorDetail(upgradeClusterResponse.HTTPResponse, upgradeClusterResponse.Body))
+	}
+
+	return waitForClusterOperation(ctx, r.client, clusterId, cratedb.UPGRADE, lastClusterOperationId(cluster), timeout)
+}
+
+// updateClusterIpWhitelist replaces the IP whitelist of a cluster and waits for
+// the update operation to finish.
+func (r *ClusterResource) updateClusterIpWhitelist(ctx context.Context, clusterId string, cluster *cratedb.Cluster, ipWhitelistSet types.Set, timeout time.Duration) (*cratedb.Cluster, error) {
+	ipWhitelist, err := getClusterIpWhitelist(ctx, ipWhitelistSet)
+	if err != nil {
+		return nil, err
+	}
+
+	ipRestrictionsResponse, err := r.client.PutApiV2ClustersClusterIdIpRestrictionsWithResponse(ctx, clusterId, cratedb.ClusterIpWhitelistEdit{
+		IpWhitelist: ipWhitelist,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if ipRestrictionsResponse.StatusCode() != 200 || ipRestrictionsResponse.JSON200 == nil {
+		return nil, errors.New(apiErrorDetail(ipRestrictionsResponse.HTTPResponse, ipRestrictionsResponse.Body))
+	}
+
+	return waitForClusterOperation(ctx, r.client, clusterId, cratedb.ALLOWEDCIDRUPDATE, lastClusterOperationId(cluster), timeout)
+}
+
+// changeClusterProduct changes the product of a cluster and waits for the
+// change operation to finish.
+func (r *ClusterResource) changeClusterProduct(ctx context.Context, clusterId string, cluster *cratedb.Cluster, productName string, timeout time.Duration) (*cratedb.Cluster, error) {
+	changeProductResponse, err := r.client.PutApiV2ClustersClusterIdProductWithResponse(ctx, clusterId, cratedb.ClusterProduct{
+		ProductName: productName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if changeProductResponse.StatusCode() != 200 || changeProductResponse.JSON200 == nil {
+		return nil, errors.New(apiErrorDetail(changeProductResponse.HTTPResponse, changeProductResponse.Body))
+	}
+
+	return waitForClusterOperation(ctx, r.client, clusterId, cratedb.CHANGECOMPUTE, lastClusterOperationId(cluster), timeout)
+}
+
+// expandClusterStorage expands the disk size per node of a cluster and waits
+// for the expansion operation to finish.
+func (r *ClusterResource) expandClusterStorage(ctx context.Context, clusterId string, cluster *cratedb.Cluster, diskSizePerNodeBytes int64, timeout time.Duration) (*cratedb.Cluster, error) {
+	diskSize := int(diskSizePerNodeBytes)
+	expandStorageResponse, err := r.client.PutApiV2ClustersClusterIdStorageWithResponse(ctx, clusterId, cratedb.ClusterStorageExpand{
+		DiskSizePerNodeBytes: &diskSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if expandStorageResponse.StatusCode() != 200 || expandStorageResponse.JSON200 == nil {
+		return nil, errors.New(apiErrorDetail(expandStorageResponse.HTTPResponse, expandStorageResponse.Body))
+	}
+
+	return waitForClusterOperation(ctx, r.client, clusterId, cratedb.EXPANDSTORAGE, lastClusterOperationId(cluster), timeout)
+}
+
+// updateClusterBackupSchedule sets the backup hours of a cluster to the hours
+// of the given schedule and waits for the update operation to finish.
+func (r *ClusterResource) updateClusterBackupSchedule(ctx context.Context, clusterId string, cluster *cratedb.Cluster, schedule string, timeout time.Duration) (*cratedb.Cluster, error) {
+	hours, err := parseBackupSchedule(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	backupHours := make([]string, 0, len(hours))
+	for _, hour := range hours {
+		backupHours = append(backupHours, strconv.Itoa(hour))
+	}
+
+	backupScheduleResponse, err := r.client.PutApiV2ClustersClusterIdBackupScheduleWithResponse(ctx, clusterId, cratedb.ClusterBackupSchedule{
+		BackupHours: strings.Join(backupHours, ","),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if backupScheduleResponse.StatusCode() != 200 || backupScheduleResponse.JSON200 == nil {
+		return nil, errors.New(apiErrorDetail(backupScheduleResponse.HTTPResponse, backupScheduleResponse.Body))
+	}
+
+	return waitForClusterOperation(ctx, r.client, clusterId, cratedb.BACKUPSCHEDULEUPDATE, lastClusterOperationId(cluster), timeout)
+}
+
+// Types of a snapshot restore: the whole snapshot or only selected tables.
+const (
+	clusterRestoreTypeAll    = "all"
+	clusterRestoreTypeTables = "tables"
+)
+
+// restoreClusterSnapshot restores a snapshot of another cluster into the
+// cluster and waits for the restore to finish. Without a configured
+// snapshot the latest snapshot of the source cluster is restored, and
+// without a configured repository the snapshot is looked up in the
+// snapshots of the source cluster.
+func (r *ClusterResource) restoreClusterSnapshot(ctx context.Context, clusterId string, cluster *cratedb.Cluster, restoreFromObject types.Object, timeout time.Duration) (*cratedb.Cluster, error) {
+	var restoreFrom ClusterRestoreFromModel
+	if diags := restoreFromObject.As(ctx, &restoreFrom, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return nil, fmt.Errorf("error converting restore_from value: %v", diags.Errors())
+	}
+
+	repository := restoreFrom.Repository.ValueString()
+	snapshotName := restoreFrom.Snapshot.ValueString()
+	if restoreFrom.Repository.IsNull() || restoreFrom.Snapshot.IsNull() {
+		snapshots, err := listClusterSnapshots(ctx, r.client, restoreFrom.ClusterId.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("could not list the snapshots of cluster %s: %w", restoreFrom.ClusterId.ValueString(), err)
+		}
+		if restoreFrom.Snapshot.IsNull() {
+			// Snapshots are listed latest first.
+			if len(snapshots) == 0 || snapshots[0].Snapshot == nil {
+				return nil, fmt.Errorf("cluster %s has no snapshots", restoreFrom.ClusterId.ValueString())
+			}
+			snapshotName = *snapshots[0].Snapshot
+		}
+		if restoreFrom.Repository.IsNull() {
+			for _, snapshot := range snapshots {
+				if snapshot.Snapshot != nil && *snapshot.Snapshot == snapshotName && snapshot.Repository != nil {
+					repository = *snapshot.Repository
+					break
+				}
+			}
+			if repository == "" {
+				return nil, fmt.Errorf("no snapshot named %q found for cluster %s", snapshotName, restoreFrom.ClusterId.ValueString())
+			}
+		}
+	}
+
+	restoreRequest := cratedb.ClusterSnapshotRestore{
+		Repository:      repository,
+		Snapshot:        snapshotName,
+		SourceClusterId: restoreFrom.ClusterId.ValueStringPointer(),
+	}
+	restoreType := clusterRestoreTypeAll
+	if !restoreFrom.Tables.IsNull() {
+		var tables []string
+		if diags := restoreFrom.Tables.ElementsAs(ctx, &tables, false); diags.HasError() {
+			return nil, fmt.Errorf("error converting restore_from tables value: %v", diags.Errors())
+		}
+		restoreRequest.Tables = &tables
+		restoreType = clusterRestoreTypeTables
+	}
+	restoreRequest.Type = &restoreType
+
+	// The restored snapshot is returned with timestamps the generated client
+	// cannot always parse, so the raw client is used.
+	restoreResponse, err := r.client.PostApiV2ClustersClusterIdSnapshotsRestore(ctx, clusterId, restoreRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	var restoredSnapshot apiClusterSnapshot
+	if err := decodeApiResponse(restoreResponse, http.StatusOK, &restoredSnapshot); err != nil {
+		return nil, err
+	}
+
+	return waitForClusterOperation(ctx, r.client, clusterId, cratedb.RESTORESNAPSHOT, lastClusterOperationId(cluster), timeout)
+}
+
+// updateClusterDeletionProtection enables or disables the deletion protection
+// of a cluster. Unlike most cluster changes it takes effect immediately.
+func (r *ClusterResource) updateClusterDeletionProtection(ctx context.Context, clusterId string, deletionProtected bool) (*cratedb.Cluster, error) {
+	deletionProtectionResponse, err := r.client.PutApiV2ClustersClusterIdDeletionProtectionWithResponse(ctx, clusterId, cratedb.ClusterDeletionProtection{
+		DeletionProtected: deletionProtected,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if deletionProtectionResponse.StatusCode() != 200 || deletionProtectionResponse.JSON200 == nil {
+		return nil, errors.New(apiErrorDetail(deletionProtectionResponse.HTTPResponse, deletionProtectionResponse.Body))
+	}
+	return deletionProtectionResponse.JSON200, nil
+}
+
+// clusterReplaceAttribute is an attribute whose plan modifier forces a new
+// cluster, optionally only while it is configured.
+type clusterReplaceAttribute struct {
+	path         path.Path
+	ifConfigured bool
+}
+
+// clusterReplaceAttributes are the attributes with a RequiresReplace or
+// RequiresReplaceIfConfigured plan modifier in the schema.
+var clusterReplaceAttributes = []clusterReplaceAttribute{
+	{path: path.Root("hardware_specs").AtName("cpus_per_node"), ifConfigured: true},
+	{path: path.Root("hardware_specs").AtName("disk_type"), ifConfigured: true},
+	{path: path.Root("hardware_specs").AtName("disks_per_node"), ifConfigured: true},
+	{path: path.Root("hardware_specs").AtName("heap_size_bytes"), ifConfigured: true},
+	{path: path.Root("hardware_specs").AtName("memory_per_node_bytes"), ifConfigured: true},
+	{path: path.Root("product_tier")},
+	{path: path.Root("restore_from")},
+}
+
+// clusterRequiresReplace reports whether the plan forces a new cluster. The
+// framework only records the replacement triggered by attribute plan
+// modifiers after ModifyPlan returned, so their rules are applied to
+// clusterReplaceAttributes here.
+func clusterRequiresReplace(ctx context.Context, req resource.ModifyPlanRequest) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	for _, attribute := range clusterReplaceAttributes {
+		var configValue, planValue, stateValue attr.Value
+		diags.Append(req.Config.GetAttribute(ctx, attribute.path, &configValue)...)
+		diags.Append(req.Plan.GetAttribute(ctx, attribute.path, &planValue)...)
+		diags.Append(req.State.GetAttribute(ctx, attribute.path, &stateValue)...)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		if attribute.ifConfigured && configValue.IsNull() {
+			continue
+		}
+		if !planValue.Equal(stateValue) {
+			return true, diags
+		}
+	}
+	return false, diags
+}
+
+// clusterDeletionProtectedDetail explains how to destroy a protected cluster.
+func clusterDeletionProtectedDetail(name string) string {
+	return fmt.Sprintf("Cluster %q has deletion protection enabled, so it cannot be destroyed or replaced. "+
+		"To proceed, set deletion_protected = false in the configuration and apply that change first, then destroy or replace the cluster.", name)
+}
+
+// suspendCluster suspends or resumes a cluster and waits until it reached the
+// requested state.
+func (r *ClusterResource) suspendCluster(ctx context.Context, clusterId string, cluster *cratedb.Cluster, suspended bool, timeout time.Duration) (*cratedb.Cluster, error) {
+	if suspended && cluster.AllowSuspend != nil && !*cluster.AllowSuspend {
+		return nil, fmt.Errorf("the product %q of the cluster does not allow suspending it (allow_suspend is false)", cluster.ProductName)
+	}
+
+	suspendClusterResponse, err := r.client.PutApiV2ClustersClusterIdSuspendWithResponse(ctx, clusterId, cratedb.ClusterSuspend{
+		Suspended: suspended,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if suspendClusterResponse.StatusCode() != 200 || suspendClusterResponse.JSON200 == nil {
+		return nil, errors.New(apiErrorDetail(suspendClusterResponse.HTTPResponse, suspendClusterResponse.Body))
+	}
+
+	// Suspending and resuming are both SUSPEND operations.
+	cluster, err = waitForClusterOperation(ctx, r.client, clusterId, cratedb.SUSPEND, lastClusterOperationId(cluster), timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster.Suspended == nil || *cluster.Suspended != suspended {
+		return nil, fmt.Errorf("the suspend operation finished, but the cluster is not in the requested state (suspended = %t)", suspended)
+	}
+	return cluster, nil
+}
+
+// crateVersionPattern matches the major, minor and hotfix numbers at the start
+// of a CrateDB version. Nightly versions carry a suffix after them.
+var crateVersionPattern = regexp.MustCompile(`^(\d+)\.(\d+)\.(\d+)`)
+
+// compareCrateVersions compares two CrateDB versions by major, minor and hotfix
+// number and returns -1, 0 or 1. ok is false when either version cannot be
+// parsed, in which case the API is left to judge the version.
+func compareCrateVersions(a, b string) (comparison int, ok bool) {
+	aMatch := crateVersionPattern.FindStringSubmatch(a)
+	bMatch := crateVersionPattern.FindStringSubmatch(b)
+	if aMatch == nil || bMatch == nil {
+		return 0, false
+	}
+
+	for i := 1; i < len(aMatch); i++ {
+		aNumber, aErr := strconv.Atoi(aMatch[i])
+		bNumber, bErr := strconv.Atoi(bMatch[i])
+		if aErr != nil || bErr != nil {
+			return 0, false
+		}
+		if comparison := cmp.Compare(aNumber, bNumber); comparison != 0 {
+			return comparison, true
+		}
+	}
+	return 0, true
+}
+
+// clusterProductUnit returns the product unit the plan asks for. A cluster
+// with product unit n runs n+1 nodes, so num_nodes is converted when it is the
+// attribute that was configured.
+func clusterProductUnit(plan ClusterModel) int {
+	switch {
+	case !plan.ProductUnit.IsUnknown() && !plan.ProductUnit.IsNull():
+		return int(plan.ProductUnit.ValueInt32())
+	case !plan.NumNodes.IsUnknown() && !plan.NumNodes.IsNull():
+		return int(plan.NumNodes.ValueInt32()) - 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,324 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+func TestCompareCrateVersions(t *testing.T) {
+	testCases := []struct {
+		a, b       string
+		comparison int
+		ok         bool
+	}{
+		{a: "5.8.2", b: "5.8.2", comparison: 0, ok: true},
+		{a: "5.8.3", b: "5.8.2", comparison: 1, ok: true},
+		{a: "5.8.2", b: "5.9.0", comparison: -1, ok: true},
+		{a: "5.10.1", b: "5.9.11", comparison: 1, ok: true},
+		{a: "4.8.4", b: "5.0.0", comparison: -1, ok: true},
+		// Nightly versions carry a date suffix.
+		{a: "5.9.0-2024.10.08", b: "5.8.2", comparison: 1, ok: true},
+		{a: "latest", b: "5.8.2", ok: false},
+	}
+
+	for _, testCase := range testCases {
+		comparison, ok := compareCrateVersions(testCase.a, testCase.b)
+		if comparison != testCase.comparison || ok != testCase.ok {
+			t.Errorf("compareCrateVersions(%q, %q) = %d, %t, want %d, %t", testCase.a, testCase.b, comparison, ok, testCase.comparison, testCase.ok)
+		}
+	}
+}
+
+func TestClusterModifyPlanDeletionProtection(t *testing.T) {
+	ctx := context.Background()
+	r := &ClusterResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	clusterSchema := schemaResp.Schema
+
+	hardwareSpecs := func(cpusPerNode int32) types.Object {
+		return types.ObjectValueMust(ClusterHardwareSpecsModel{}.GetAttrType(), map[string]attr.Value{
+			"cpus_per_node":            types.Int32Value(cpusPerNode),
+			"disk_size_per_node_bytes": types.Int64Value(34359738368),
+			"disk_type":                types.StringValue("premium"),
+			"disks_per_node":           types.Int32Value(1),
+			"heap_size_bytes":          types.Int64Value(1073741824),
+			"memory_per_node_bytes":    types.Int64Value(2147483648),
+		})
+	}
+
+	base := func(deletionProtected bool) map[string]attr.Value {
+		return map[string]attr.Value{
+			"channel":            types.StringValue("stable"),
+			"deletion_protected": types.BoolValue(deletionProtected),
+			"hardware_specs":     hardwareSpecs(2),
+			"name":               types.StringValue("protected"),
+			"product_name":       types.StringValue("cr4"),
+			"product_tier":       types.StringValue("default"),
+		}
+	}
+	with := func(values map[string]attr.Value, name string, value attr.Value) map[string]attr.Value {
+		values[name] = value
+		return values
+	}
+
+	testCases := map[string]struct {
+		state   map[string]attr.Value
+		plan    map[string]attr.Value
+		config  map[string]attr.Value
+		wantErr bool
+	}{
+		"product tier change": {
+			state:   base(true),
+			plan:    with(base(true), "product_tier", types.StringValue("premium")),
+			wantErr: true,
+		},
+		"restore_from added": {
+			state: base(true),
+			plan: with(base(true), "restore_from", types.ObjectValueMust(ClusterRestoreFromModel{}.GetAttrType(), map[string]attr.Value{
+				"cluster_id": types.StringValue("7e1c3a2e-0000-4000-8000-000000000002"),
+				"repository": types.StringNull(),
+				"snapshot":   types.StringNull(),
+				"tables":     types.ListNull(types.StringType),
+			})),
+			wantErr: true,
+		},
+		"configured hardware spec change": {
+			state:   base(true),
+			plan:    with(base(true), "hardware_specs", hardwareSpecs(4)),
+			config:  map[string]attr.Value{"hardware_specs": hardwareSpecs(4)},
+			wantErr: true,
+		},
+		"unconfigured hardware spec change": {
+			state: base(true),
+			plan:  with(base(true), "hardware_specs", hardwareSpecs(4)),
+		},
+		"in-place change": {
+			state: base(true),
+			plan:  with(base(true), "name", types.StringValue("renamed")),
+		},
+		"unprotected product tier change": {
+			state: base(false),
+			plan:  with(base(false), "product_tier", types.StringValue("premium")),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := testCase.config
+			if config == nil {
+				config = map[string]attr.Value{}
+			}
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: clusterSchema, Raw: testResourceValue(t, clusterSchema, config)},
+				Plan:   tfsdk.Plan{Schema: clusterSchema, Raw: testResourceValue(t, clusterSchema, testCase.plan)},
+				State:  tfsdk.State{Schema: clusterSchema, Raw: testResourceValue(t, clusterSchema, testCase.state)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, resp)
+
+			gotErr := false
+			for _, diag := range resp.Diagnostics.Errors() {
+				if strings.Contains(diag.Summary(), "deletion protected") {
+					gotErr = true
+				}
+			}
+			if gotErr != testCase.wantErr {
+				t.Errorf("expected deletion protection error %t, got diagnostics %v", testCase.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestClusterModifyPlanCrateVersion(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/meta/cratedb-versions/" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"crate_versions":{"stable":[{"version":"5.9.12"},{"version":"5.10.11"}],"testing":{"version":"6.0.0"}}}`))
+	}))
+	defer server.Close()
+
+	client, err := cratedb.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+	r := &ClusterResource{client: client}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	clusterSchema := schemaResp.Schema
+
+	cluster := func(channel, crateVersion string) map[string]attr.Value {
+		return map[string]attr.Value{
+			"channel":       types.StringValue(channel),
+			"crate_version": types.StringValue(crateVersion),
+			"name":          types.StringValue("cluster"),
+		}
+	}
+
+	testCases := map[string]struct {
+		plan        map[string]attr.Value
+		wantSummary string
+	}{
+		"unchanged": {
+			plan: cluster("stable", "5.9.12"),
+		},
+		"upgrade within channel": {
+			plan: cluster("stable", "5.10.11"),
+		},
+		"upgrade to version of another channel": {
+			plan:        cluster("stable", "6.0.0"),
+			wantSummary: "CrateDB version not available",
+		},
+		"downgrade": {
+			plan:        cluster("stable", "5.8.0"),
+			wantSummary: "Unsupported CrateDB version downgrade",
+		},
+		"channel change": {
+			plan:        cluster("testing", "6.0.0"),
+			wantSummary: "Cluster channel cannot be changed",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: clusterSchema, Raw: testResourceValue(t, clusterSchema, testCase.plan)},
+				Plan:   tfsdk.Plan{Schema: clusterSchema, Raw: testResourceValue(t, clusterSchema, testCase.plan)},
+				State:  tfsdk.State{Schema: clusterSchema, Raw: testResourceValue(t, clusterSchema, cluster("stable", "5.9.12"))},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, resp)
+
+			var summaries []string
+			for _, diag := range resp.Diagnostics.Errors() {
+				summaries = append(summaries, diag.Summary())
+			}
+			switch {
+			case testCase.wantSummary == "" && len(summaries) > 0:
+				t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+			case testCase.wantSummary != "" && !slices.Equal(summaries, []string{testCase.wantSummary}):
+				t.Errorf("expected error %q, got %v", testCase.wantSummary, summaries)
+			}
+		})
+	}
+}
+
+func TestClusterReplaceAttributes(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	(&ClusterResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	requiresReplace := stringplanmodifier.RequiresReplace().Description(ctx)
+	requiresReplaceIfConfigured := stringplanmodifier.RequiresReplaceIfConfigured().Description(ctx)
+
+	// Collect the attributes of the schema that force a new cluster.
+	schemaAttributes := map[string]bool{}
+	var walk func(parent path.Path, attributes map[string]schema.Attribute)
+	walk = func(parent path.Path, attributes map[string]schema.Attribute) {
+		for name, attribute := range attributes {
+			attributePath := parent.AtName(name)
+			for _, planModifier := range testPlanModifiers(attribute) {
+				switch planModifier.Description(ctx) {
+				case requiresReplace:
+					schemaAttributes[attributePath.String()] = false
+				case requiresReplaceIfConfigured:
+					schemaAttributes[attributePath.String()] = true
+				}
+			}
+			if nested, ok := attribute.(schema.SingleNestedAttribute); ok {
+				walk(attributePath, nested.Attributes)
+			}
+		}
+	}
+	walk(path.Empty(), schemaResp.Schema.Attributes)
+
+	replaceAttributes := map[string]bool{}
+	for _, attribute := range clusterReplaceAttributes {
+		replaceAttributes[attribute.path.String()] = attribute.ifConfigured
+	}
+
+	if !maps.Equal(schemaAttributes, replaceAttributes) {
+		t.Errorf("clusterReplaceAttributes %v do not match the replacing attributes of the schema %v", replaceAttributes, schemaAttributes)
+	}
+}
+
+// testPlanModifiers returns the plan modifiers of a schema attribute.
+func testPlanModifiers(attribute schema.Attribute) []planmodifier.Describer {
+	var planModifiers []planmodifier.Describer
+	switch attribute := attribute.(type) {
+	case schema.BoolAttribute:
+		for _, planModifier := range attribute.PlanModifiers {
+			planModifiers = append(planModifiers, planModifier)
+		}
+	case schema.Int32Attribute:
+		for _, planModifier := range attribute.PlanModifiers {
+			planModifiers = append(planModifiers, planModifier)
+		}
+	case schema.Int64Attribute:
+		for _, planModifier := range attribute.PlanModifiers {
+			planModifiers = append(planModifiers, planModifier)
+		}
+	case schema.ListAttribute:
+		for _, planModifier := range attribute.PlanModifiers {
+			planModifiers = append(planModifiers, planModifier)
+		}
+	case schema.SetAttribute:
+		for _, planModifier := range attribute.PlanModifiers {
+			planModifiers = append(planModifiers, planModifier)
+		}
+	case schema.SetNestedAttribute:
+		for _, planModifier := range attribute.PlanModifiers {
+			planModifiers = append(planModifiers, planModifier)
+		}
+	case schema.SingleNestedAttribute:
+		for _, planModifier := range attribute.PlanModifiers {
+			planModifiers = append(planModifiers, planModifier)
+		}
+	case schema.StringAttribute:
+		for _, planModifier := range attribute.PlanModifiers {
+			planModifiers = append(planModifiers, planModifier)
+		}
+	}
+	return planModifiers
+}
+
+// testResourceValue builds a value of the resource schema with every attribute
+// null except the given ones.
+func testResourceValue(t *testing.T, resourceSchema schema.Schema, values map[string]attr.Value) tftypes.Value {
+	t.Helper()
+	ctx := context.Background()
+	objectType := resourceSchema.Type().TerraformType(ctx).(tftypes.Object)
+	nullAttributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		nullAttributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	state := tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(objectType, nullAttributes)}
+	for name, value := range values {
+		if diags := state.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatalf("setting %s: %v", name, diags)
+		}
+	}
+	return state.Raw
+}
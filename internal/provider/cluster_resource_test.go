@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 // TestAccClusterResource deploys a real cluster and therefore needs a project
@@ -15,7 +16,7 @@ func TestAccClusterResource(t *testing.T) {
 	organizationID := envOrSkip(t, "CRATEDB_ORGANIZATION_ID")
 	projectID := envOrSkip(t, "CRATEDB_PROJECT_ID")
 	subscriptionID := envOrSkip(t, "CRATEDB_SUBSCRIPTION_ID")
-	crateVersion := envOrSkip(t, "CRATEDB_CRATE_VERSION")
+	crateVersion := discoverCrateVersion(t)
 	productName := envOrDefault("CRATEDB_PRODUCT_NAME", "crfree")
 	productTier := envOrDefault("CRATEDB_PRODUCT_TIER", "default")
 
@@ -78,3 +79,64 @@ resource "cratedb_cluster" "test" {
 		},
 	})
 }
+
+// TestAccClusterResource_passwordWriteOnly deploys a cluster with a write-only
+// password, which needs Terraform 1.11 or later.
+func TestAccClusterResource_passwordWriteOnly(t *testing.T) {
+	organizationID := envOrSkip(t, "CRATEDB_ORGANIZATION_ID")
+	projectID := envOrSkip(t, "CRATEDB_PROJECT_ID")
+	subscriptionID := envOrSkip(t, "CRATEDB_SUBSCRIPTION_ID")
+	crateVersion := discoverCrateVersion(t)
+	productName := envOrDefault("CRATEDB_PRODUCT_NAME", "crfree")
+	productTier := envOrDefault("CRATEDB_PRODUCT_TIER", "default")
+
+	name := acctest.RandomWithPrefix("tf-acc-test")
+	firstPassword := acctest.RandomWithPrefix("tf-acc-password")
+	secondPassword := acctest.RandomWithPrefix("tf-acc-password")
+
+	clusterConfig := func(password string, passwordVersion int) string {
+		return testAccProviderConfig + fmt.Sprintf(`
+resource "cratedb_cluster" "test" {
+  organization_id     = %q
+  crate_version       = %q
+  name                = %q
+  product_name        = %q
+  product_tier        = %q
+  project_id          = %q
+  subscription_id     = %q
+  username            = "admin"
+  password_wo         = %q
+  password_wo_version = %d
+}
+`, organizationID, crateVersion, name, productName, productTier, projectID, subscriptionID, password, passwordVersion)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: clusterConfig(firstPassword, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cratedb_cluster.test", "name", name),
+					resource.TestCheckResourceAttr("cratedb_cluster.test", "password_wo_version", "1"),
+					resource.TestCheckNoResourceAttr("cratedb_cluster.test", "password_wo"),
+					resource.TestCheckNoResourceAttr("cratedb_cluster.test", "password"),
+				),
+			},
+			// Update (password rotation) and Read testing
+			{
+				Config: clusterConfig(secondPassword, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cratedb_cluster.test", "password_wo_version", "2"),
+					resource.TestCheckNoResourceAttr("cratedb_cluster.test", "password_wo"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// apiClusterSnapshot mirrors cratedb.ClusterSnapshot with tolerant timestamp
+// decoding.
+type apiClusterSnapshot struct {
+	Created    *apiTime `json:"created"`
+	Repository *string  `json:"repository"`
+	Snapshot   *string  `json:"snapshot"`
+	Tables     []string `json:"tables"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ClusterSnapshotsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ClusterSnapshotsDataSource{}
+)
+
+// NewClusterSnapshotsDataSource is a helper function to simplify the provider implementation.
+func NewClusterSnapshotsDataSource() datasource.DataSource {
+	return &ClusterSnapshotsDataSource{}
+}
+
+// ClusterSnapshotsDataSource is the data source implementation.
+type ClusterSnapshotsDataSource struct {
+	client *cratedb.ClientWithResponses
+}
+
+// ClusterSnapshotsDataSourceModel describes the data source data model.
+type ClusterSnapshotsDataSourceModel struct {
+	ClusterId types.String           `tfsdk:"cluster_id"`
+	Snapshots []ClusterSnapshotModel `tfsdk:"snapshots"`
+}
+
+// ClusterSnapshotModel maps CrateDB cluster snapshot schema data.
+type ClusterSnapshotModel struct {
+	Created    timetypes.RFC3339 `tfsdk:"created"`
+	Repository types.String      `tfsdk:"repository"`
+	Snapshot   types.String      `tfsdk:"snapshot"`
+	Tables     types.List        `tfsdk:"tables"`
+}
+
+// Metadata returns the data source type name.
+func (d *ClusterSnapshotsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_snapshots"
+}
+
+// Schema defines the schema for the data source.
+func (d *ClusterSnapshotsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "To retrieve the snapshots (backups) of a cluster, latest first. CrateDB Cloud keeps the snapshots of the last two weeks.",
+
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the cluster.",
+			},
+			"snapshots": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The list of snapshots.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"created": schema.StringAttribute{
+							CustomType:  timetypes.RFC3339Type{},
+							Computed:    true,
+							Description: "The time the snapshot was taken.",
+						},
+						"repository": schema.StringAttribute{
+							Computed:    true,
+							Description: "The repository the snapshot is stored in.",
+						},
+						"snapshot": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the snapshot.",
+						},
+						"tables": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The tables contained in the snapshot.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ClusterSnapshotsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := clientFromProviderData(req.ProviderData, "Data Source", &resp.Diagnostics); client != nil {
+		d.client = client
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ClusterSnapshotsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ClusterSnapshotsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshots, err := listClusterSnapshots(ctx, d.client, state.ClusterId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting cluster snapshots",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Snapshots = []ClusterSnapshotModel{}
+	for _, snapshot := range snapshots {
+		snapshotState, err := getClusterSnapshotModel(ctx, snapshot)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error getting cluster snapshot model",
+				err.Error(),
+			)
+			return
+		}
+		state.Snapshots = append(state.Snapshots, *snapshotState)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func getClusterSnapshotModel(ctx context.Context, snapshot apiClusterSnapshot) (*ClusterSnapshotModel, error) {
+	created := timetypes.NewRFC3339Null()
+	if snapshot.Created != nil {
+		created = timetypes.NewRFC3339TimeValue(snapshot.Created.Time)
+	}
+
+	tables, diags := types.ListValueFrom(ctx, types.StringType, snapshot.Tables)
+	if diags.HasError() {
+		return nil, fmt.Errorf("error getting cluster snapshot tables value: %v", diags.Errors())
+	}
+
+	return &ClusterSnapshotModel{
+		Created:    created,
+		Repository: types.StringPointerValue(snapshot.Repository),
+		Snapshot:   types.StringPointerValue(snapshot.Snapshot),
+		Tables:     tables,
+	}, nil
+}
+
+// listClusterSnapshots lists the snapshots of a cluster, latest first. The
+// raw client is used because the snapshot timestamps are not always RFC3339.
+func listClusterSnapshots(ctx context.Context, client *cratedb.ClientWithResponses, clusterId string) ([]apiClusterSnapshot, error) {
+	snapshotsResponse, err := client.GetApiV2ClustersClusterIdSnapshots(ctx, clusterId, &cratedb.GetApiV2ClustersClusterIdSnapshotsParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []apiClusterSnapshot
+	if err := decodeApiResponse(snapshotsResponse, http.StatusOK, &snapshots); err != nil {
+		return nil, err
+	}
+
+	// The API does not guarantee an order. Snapshots without a created time
+	// are listed last.
+	slices.SortStableFunc(snapshots, func(a, b apiClusterSnapshot) int {
+		switch {
+		case a.Created == nil && b.Created == nil:
+			return 0
+		case a.Created == nil:
+			return 1
+		case b.Created == nil:
+			return -1
+		}
+		return b.Created.Compare(a.Created.Time)
+	})
+	return snapshots, nil
+}
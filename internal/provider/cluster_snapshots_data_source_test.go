@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccClusterSnapshotsDataSource lists the snapshots of an existing
+// cluster. A new cluster may not have any yet, so only the attribute is
+// checked.
+func TestAccClusterSnapshotsDataSource(t *testing.T) {
+	clusterID := envOrSkip(t, "CRATEDB_CLUSTER_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + fmt.Sprintf(`
+data "cratedb_cluster_snapshots" "test" {
+  cluster_id = %q
+}
+`, clusterID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cratedb_cluster_snapshots.test", "cluster_id", clusterID),
+					resource.TestCheckResourceAttrSet("data.cratedb_cluster_snapshots.test", "snapshots.#"),
+				),
+			},
+		},
+	})
+}
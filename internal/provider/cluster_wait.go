@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// Statuses of a cluster async operation that end the wait.
+const (
+	clusterOperationSucceeded = "SUCCEEDED"
+	clusterOperationFailed    = "FAILED"
+)
+
+// clusterOperationPollInterval is how often the cluster is polled while an
+// async operation is running. It is a variable so tests can shorten it.
+var clusterOperationPollInterval = 10 * time.Second
+
+// apiClusterOperation mirrors cratedb.ClusterAsyncOperation with tolerant
+// timestamp decoding.
+type apiClusterOperation struct {
+	Dc           *apiDublinCore `json:"dc"`
+	FeedbackData map[string]any `json:"feedback_data"`
+	Id           *string        `json:"id"`
+	Status       *string        `json:"status"`
+	Type         *string        `json:"type"`
+}
+
+// apiClusterOperationsList mirrors cratedb.ClusterAsyncOperationsList.
+type apiClusterOperationsList struct {
+	Operations []apiClusterOperation `json:"operations"`
+}
+
+// lastClusterOperationId returns the id of the cluster's last async
+// operation, or an empty string when the cluster has none. Callers record it
+// before triggering a new operation so the wait does not mistake the previous
+// operation for the new one.
+func lastClusterOperationId(cluster *cratedb.Cluster) string {
+	if cluster == nil || cluster.LastAsyncOperation == nil || cluster.LastAsyncOperation.Id == nil {
+		return ""
+	}
+	return *cluster.LastAsyncOperation.Id
+}
+
+// getCluster reads a cluster and returns an error for any response other than
+// a well-formed 200.
+func getCluster(ctx context.Context, client *cratedb.ClientWithResponses, clusterId string) (*cratedb.Cluster, error) {
+	readClusterResponse, err := client.GetApiV2ClustersClusterIdWithResponse(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	if readClusterResponse.StatusCode() != 200 || readClusterResponse.JSON200 == nil {
+		return nil, errors.New(apiErrorDetail(readClusterResponse.HTTPResponse, readClusterResponse.Body))
+	}
+	return readClusterResponse.JSON200, nil
+}
+
+// waitForClusterOperation polls the cluster until its last async operation is
+// of the given type, differs from previousOperationId, and has finished. It
+// returns the refreshed cluster once the operation succeeded, and an error
+// carrying the operation's feedback when it failed or the timeout expired.
+func waitForClusterOperation(ctx context.Context, client *cratedb.ClientWithResponses, clusterId string, operationType cratedb.ClusterHealthRunningOperation, previousOperationId string, timeout time.Duration) (*cratedb.Cluster, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ctx = tflog.SetField(ctx, "cluster_id", clusterId)
+	ctx = tflog.SetField(ctx, "operation_type", string(operationType))
+
+	ticker := time.NewTicker(clusterOperationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		cluster, err := getCluster(ctx, client, clusterId)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("timed out after %s waiting for the %s operation of cluster %s", timeout, operationType, clusterId)
+			}
+			return nil, fmt.Errorf("could not read cluster %s: %w", clusterId, err)
+		}
+
+		if operation := cluster.LastAsyncOperation; operation != nil && operation.Id != nil && *operation.Id != previousOperationId &&
+			operation.Type != nil && *operation.Type == string(operationType) {
+			status := ""
+			if operation.Status != nil {
+				status = *operation.Status
+			}
+			tflog.Debug(ctx, "Polled cluster operation", map[string]any{"operation_id": *operation.Id, "status": status})
+
+			switch status {
+			case clusterOperationSucceeded:
+				return cluster, nil
+			case clusterOperationFailed:
+				return nil, fmt.Errorf("the %s operation %s of cluster %s failed: %s", operationType, *operation.Id, clusterId, clusterOperationFeedback(ctx, client, clusterId, *operation.Id))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out after %s waiting for the %s operation of cluster %s", timeout, operationType, clusterId)
+		case <-ticker.C:
+		}
+	}
+}
+
+// clusterOperationFeedback looks up a cluster operation and returns the error
+// message the API reported for it. It never fails: when the feedback cannot
+// be retrieved it says so, so the caller can still report the failure.
+func clusterOperationFeedback(ctx context.Context, client *cratedb.ClientWithResponses, clusterId, operationId string) string {
+	const noFeedback = "no error details were reported by the API"
+
+	operations, err := listClusterOperations(ctx, client, clusterId, &cratedb.GetApiV2ClustersClusterIdOperationsParams{})
+	if err != nil {
+		tflog.Warn(ctx, "Could not read cluster operations", map[string]any{"error": err.Error()})
+		return noFeedback
+	}
+
+	for _, operation := range operations {
+		if operation.Id == nil || *operation.Id != operationId {
+			continue
+		}
+		if feedback := operation.feedback(); feedback != "" {
+			return feedback
+		}
+	}
+	return noFeedback
+}
+
+// feedback returns the error message the API reported for the operation, the
+// raw feedback data when it has no message, or an empty string.
+func (operation apiClusterOperation) feedback() string {
+	if message, ok := operation.FeedbackData["message"].(string); ok && message != "" {
+		return message
+	}
+	if len(operation.FeedbackData) > 0 {
+		feedback, err := json.Marshal(operation.FeedbackData)
+		if err == nil {
+			return string(feedback)
+		}
+	}
+	return ""
+}
+
+// listClusterOperations lists the async operations of a cluster. The raw
+// client is used because the operation timestamps are not always RFC3339,
+// which the generated typed client cannot parse.
+func listClusterOperations(ctx context.Context, client *cratedb.ClientWithResponses, clusterId string, params *cratedb.GetApiV2ClustersClusterIdOperationsParams) ([]apiClusterOperation, error) {
+	operationsResponse, err := client.GetApiV2ClustersClusterIdOperations(ctx, clusterId, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var operations apiClusterOperationsList
+	if err := decodeApiResponse(operationsResponse, http.StatusOK, &operations); err != nil {
+		return nil, err
+	}
+	return operations.Operations, nil
+}
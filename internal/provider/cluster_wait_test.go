@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+func TestWaitForClusterOperation(t *testing.T) {
+	clusterOperationPollInterval = time.Millisecond
+	t.Cleanup(func() { clusterOperationPollInterval = 10 * time.Second })
+
+	const clusterID = "7e1c3a2e-0000-4000-8000-000000000001"
+
+	// Each case returns the cluster payloads in order, repeating the last one.
+	testCases := map[string]struct {
+		clusters      []string
+		operationType cratedb.ClusterHealthRunningOperation
+		operations    string
+		previousID    string
+		wantErr       string
+		wantHealthy   bool
+	}{
+		"succeeded": {
+			operationType: cratedb.CREATE,
+			clusters: []string{
+				`{"id":"` + clusterID + `","last_async_operation":{"id":"op-1","type":"CREATE","status":"IN_PROGRESS"}}`,
+				`{"id":"` + clusterID + `","health":{"status":"GREEN"},"last_async_operation":{"id":"op-1","type":"CREATE","status":"SUCCEEDED"}}`,
+			},
+			wantHealthy: true,
+		},
+		"ignores previous operation": {
+			operationType: cratedb.SCALE,
+			clusters: []string{
+				`{"id":"` + clusterID + `","last_async_operation":{"id":"op-0","type":"SCALE","status":"SUCCEEDED"}}`,
+				`{"id":"` + clusterID + `","health":{"status":"GREEN"},"last_async_operation":{"id":"op-1","type":"SCALE","status":"SUCCEEDED"}}`,
+			},
+			previousID:  "op-0",
+			wantHealthy: true,
+		},
+		"failed with feedback": {
+			operationType: cratedb.CREATE,
+			clusters: []string{
+				`{"id":"` + clusterID + `","last_async_operation":{"id":"op-1","type":"CREATE","status":"FAILED"}}`,
+			},
+			// Operation timestamps have no timezone offset, as returned by the
+			// live API.
+			operations: `{"operations":[{"id":"op-1","type":"CREATE","status":"FAILED",` +
+				`"dc":{"created":"2026-07-10T10:41:02.983000","modified":"2026-07-10T10:45:02.983000"},` +
+				`"feedback_data":{"message":"insufficient quota"}}]}`,
+			wantErr: "insufficient quota",
+		},
+		"timed out": {
+			operationType: cratedb.CREATE,
+			clusters: []string{
+				`{"id":"` + clusterID + `","last_async_operation":{"id":"op-1","type":"CREATE","status":"IN_PROGRESS"}}`,
+			},
+			wantErr: "timed out",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var polls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/api/v2/clusters/" + clusterID + "/":
+					poll := int(polls.Add(1)) - 1
+					_, _ = w.Write([]byte(testCase.clusters[min(poll, len(testCase.clusters)-1)]))
+				case "/api/v2/clusters/" + clusterID + "/operations/":
+					_, _ = w.Write([]byte(testCase.operations))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			client, err := cratedb.NewClientWithResponses(server.URL)
+			if err != nil {
+				t.Fatalf("creating client: %v", err)
+			}
+
+			cluster, err := waitForClusterOperation(context.Background(), client, clusterID, testCase.operationType, testCase.previousID, 200*time.Millisecond)
+			if testCase.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if testCase.wantHealthy && (cluster.Health == nil || cluster.Health.Status == nil || *cluster.Health.Status != "GREEN") {
+				t.Errorf("expected the refreshed cluster to be returned, got %+v", cluster)
+			}
+		})
+	}
+}
+
+func TestRestoreClusterSnapshot(t *testing.T) {
+	clusterOperationPollInterval = time.Millisecond
+	t.Cleanup(func() { clusterOperationPollInterval = 10 * time.Second })
+
+	const (
+		clusterID       = "7e1c3a2e-0000-4000-8000-000000000001"
+		sourceClusterID = "7e1c3a2e-0000-4000-8000-000000000002"
+	)
+
+	testCases := map[string]struct {
+		restoreFrom ClusterRestoreFromModel
+		wantBody    string
+		wantErr     string
+	}{
+		"all tables from looked up repository": {
+			restoreFrom: ClusterRestoreFromModel{
+				ClusterId:  types.StringValue(sourceClusterID),
+				Repository: types.StringNull(),
+				Snapshot:   types.StringValue("snap-2"),
+				Tables:     types.ListNull(types.StringType),
+			},
+			wantBody: `{"repository":"repo-b","snapshot":"snap-2","source_cluster_id":"` + sourceClusterID + `","type":"all"}`,
+		},
+		"selected tables": {
+			restoreFrom: ClusterRestoreFromModel{
+				ClusterId:  types.StringValue(sourceClusterID),
+				Repository: types.StringValue("repo-a"),
+				Snapshot:   types.StringValue("snap-1"),
+				Tables:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("doc.orders")}),
+			},
+			wantBody: `{"repository":"repo-a","snapshot":"snap-1","source_cluster_id":"` + sourceClusterID + `","tables":["doc.orders"],"type":"tables"}`,
+		},
+		"latest snapshot": {
+			restoreFrom: ClusterRestoreFromModel{
+				ClusterId:  types.StringValue(sourceClusterID),
+				Repository: types.StringNull(),
+				Snapshot:   types.StringNull(),
+				Tables:     types.ListNull(types.StringType),
+			},
+			wantBody: `{"repository":"repo-b","snapshot":"snap-2","source_cluster_id":"` + sourceClusterID + `","type":"all"}`,
+		},
+		"no snapshots": {
+			restoreFrom: ClusterRestoreFromModel{
+				ClusterId:  types.StringValue(clusterID),
+				Repository: types.StringNull(),
+				Snapshot:   types.StringNull(),
+				Tables:     types.ListNull(types.StringType),
+			},
+			wantErr: "has no snapshots",
+		},
+		"unknown snapshot": {
+			restoreFrom: ClusterRestoreFromModel{
+				ClusterId:  types.StringValue(sourceClusterID),
+				Repository: types.StringNull(),
+				Snapshot:   types.StringValue("snap-9"),
+				Tables:     types.ListNull(types.StringType),
+			},
+			wantErr: `no snapshot named "snap-9"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var restoreBody string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/api/v2/clusters/" + sourceClusterID + "/snapshots/":
+					// Snapshot timestamps have no timezone offset, as returned
+					// by the live API, which does not list the latest first.
+					_, _ = w.Write([]byte(`[{"snapshot":"snap-1","repository":"repo-a","created":"2026-07-09T10:41:02.983000"},` +
+						`{"snapshot":"snap-2","repository":"repo-b","created":"2026-07-10T10:41:02.983000"},` +
+						`{"snapshot":"snap-0","repository":"repo-a","created":"2026-07-08T10:41:02.983000"}]`))
+				case "/api/v2/clusters/" + clusterID + "/snapshots/":
+					_, _ = w.Write([]byte(`[]`))
+				case "/api/v2/clusters/" + clusterID + "/snapshots/restore/":
+					body, _ := io.ReadAll(r.Body)
+					restoreBody = strings.TrimSpace(string(body))
+					_, _ = w.Write([]byte(`{"snapshot":"snap-1","created":"2026-07-09T10:41:02.983000"}`))
+				case "/api/v2/clusters/" + clusterID + "/":
+					_, _ = w.Write([]byte(`{"id":"` + clusterID + `","last_async_operation":{"id":"op-2","type":"RESTORE_SNAPSHOT","status":"SUCCEEDED"}}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			client, err := cratedb.NewClientWithResponses(server.URL)
+			if err != nil {
+				t.Fatalf("creating client: %v", err)
+			}
+
+			restoreFrom, diags := types.ObjectValueFrom(context.Background(), ClusterRestoreFromModel{}.GetAttrType(), testCase.restoreFrom)
+			if diags.HasError() {
+				t.Fatalf("converting restore_from: %v", diags)
+			}
+
+			r := &ClusterResource{client: client}
+			previousOperationID := "op-1"
+			previous := &cratedb.Cluster{LastAsyncOperation: &cratedb.PartialClusterAsyncOperation{Id: &previousOperationID}}
+			_, err = r.restoreClusterSnapshot(context.Background(), clusterID, previous, restoreFrom, 200*time.Millisecond)
+			if testCase.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if restoreBody != testCase.wantBody {
+				t.Errorf("expected restore request %s, got %s", testCase.wantBody, restoreBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ClustersDataSource{}
+	_ datasource.DataSourceWithConfigure = &ClustersDataSource{}
+)
+
+// NewClustersDataSource is a helper function to simplify the provider implementation.
+func NewClustersDataSource() datasource.DataSource {
+	return &ClustersDataSource{}
+}
+
+// ClustersDataSource is the data source implementation.
+type ClustersDataSource struct {
+	client *cratedb.ClientWithResponses
+}
+
+// ClustersDataSourceModel describes the data source data model.
+type ClustersDataSourceModel struct {
+	OrganizationId types.String             `tfsdk:"organization_id"`
+	ProjectId      types.String             `tfsdk:"project_id"`
+	NameRegex      types.String             `tfsdk:"name_regex"`
+	CrateVersion   types.String             `tfsdk:"crate_version"`
+	HealthStatus   types.String             `tfsdk:"health_status"`
+	Clusters       []ClusterDataSourceModel `tfsdk:"clusters"`
+}
+
+// Metadata returns the data source type name.
+func (d *ClustersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clusters"
+}
+
+// Schema defines the schema for the data source.
+func (d *ClustersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "To retrieve all clusters, optionally filtered.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return clusters of this organization.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return clusters of this project.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return clusters whose name matches this regular expression.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"crate_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return clusters running this CrateDB version.",
+			},
+			"health_status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return clusters with this health status, one of `GREEN`, `YELLOW`, `RED`, `UNREACHABLE`, `SUSPENDED` or `UNKNOWN`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(cratedb.ClusterHealthStatusGREEN),
+						string(cratedb.ClusterHealthStatusYELLOW),
+						string(cratedb.ClusterHealthStatusRED),
+						string(cratedb.ClusterHealthStatusUNREACHABLE),
+						string(cratedb.ClusterHealthStatusSUSPENDED),
+						string(cratedb.ClusterHealthStatusUNKNOWN),
+					),
+				},
+			},
+			"clusters": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The list of clusters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: clusterDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ClustersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := clientFromProviderData(req.ProviderData, "Data Source", &resp.Diagnostics); client != nil {
+		d.client = client
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ClustersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ClustersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid name regular expression",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	clusters, err := listClusters(ctx, d.client, state.OrganizationId.ValueString(), state.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting clusters",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Clusters = []ClusterDataSourceModel{}
+	for _, cluster := range clusters {
+		if nameRegex != nil && !nameRegex.MatchString(cluster.Name) {
+			continue
+		}
+		if !state.CrateVersion.IsNull() && cluster.CrateVersion != state.CrateVersion.ValueString() {
+			continue
+		}
+		if !state.HealthStatus.IsNull() && (cluster.Health == nil || cluster.Health.Status == nil ||
+			string(*cluster.Health.Status) != state.HealthStatus.ValueString()) {
+			continue
+		}
+
+		clusterState, err := getClusterModel(ctx, cluster)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error getting cluster model",
+				err.Error(),
+			)
+			return
+		}
+		state.Clusters = append(state.Clusters, clusterDataSourceModelFrom(*clusterState))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// listClusters lists the clusters of an organization, of a project, or all
+// clusters the credentials can access when neither is given. Within an
+// organization the project filter is applied by the API.
+func listClusters(ctx context.Context, client *cratedb.ClientWithResponses, organizationId, projectId string) ([]cratedb.Cluster, error) {
+	var projectIdParam *cratedb.QueryProjectId
+	if projectId != "" {
+		projectIdParam = &projectId
+	}
+
+	switch {
+	case organizationId != "":
+		readClustersResponse, err := client.GetApiV2OrganizationsOrganizationIdClustersWithResponse(ctx, organizationId, &cratedb.GetApiV2OrganizationsOrganizationIdClustersParams{
+			ProjectId: projectIdParam,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if readClustersResponse.StatusCode() != 200 || readClustersResponse.JSON200 == nil {
+			return nil, errors.New(apiErrorDetail(readClustersResponse.HTTPResponse, readClustersResponse.Body))
+		}
+		return *readClustersResponse.JSON200, nil
+	case projectId != "":
+		readClustersResponse, err := client.GetApiV2ProjectsProjectIdClustersWithResponse(ctx, projectId, &cratedb.GetApiV2ProjectsProjectIdClustersParams{})
+		if err != nil {
+			return nil, err
+		}
+		if readClustersResponse.StatusCode() != 200 || readClustersResponse.JSON200 == nil {
+			return nil, errors.New(apiErrorDetail(readClustersResponse.HTTPResponse, readClustersResponse.Body))
+		}
+		return *readClustersResponse.JSON200, nil
+	default:
+		readClustersResponse, err := client.GetApiV2ClustersWithResponse(ctx, &cratedb.GetApiV2ClustersParams{})
+		if err != nil {
+			return nil, err
+		}
+		if readClustersResponse.StatusCode() != 200 || readClustersResponse.JSON200 == nil {
+			return nil, errors.New(apiErrorDetail(readClustersResponse.HTTPResponse, readClustersResponse.Body))
+		}
+		return *readClustersResponse.JSON200, nil
+	}
+}
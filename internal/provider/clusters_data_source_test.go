@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccClustersDataSource lists the project of an existing cluster and
+// filters it down to that cluster by name.
+func TestAccClustersDataSource(t *testing.T) {
+	clusterID := envOrSkip(t, "CRATEDB_CLUSTER_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + fmt.Sprintf(`
+data "cratedb_cluster" "test" {
+  id = %q
+}
+
+data "cratedb_clusters" "test" {
+  project_id    = data.cratedb_cluster.test.project_id
+  name_regex    = "^${data.cratedb_cluster.test.name}$"
+  crate_version = data.cratedb_cluster.test.crate_version
+}
+`, clusterID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cratedb_clusters.test", "clusters.#", "1"),
+					resource.TestCheckResourceAttr("data.cratedb_clusters.test", "clusters.0.id", clusterID),
+					resource.TestCheckResourceAttrPair("data.cratedb_clusters.test", "clusters.0.name", "data.cratedb_cluster.test", "name"),
+				),
+			},
+		},
+	})
+}
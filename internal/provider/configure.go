@@ -1,8 +1,12 @@
 package provider
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -62,3 +66,55 @@ func apiErrorDetail(httpResponse *http.Response, body []byte) string {
 func isNotFound(httpResponse *http.Response) bool {
 	return httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound
 }
+
+// findByName returns the only item called name. Names are not unique in
+// CrateDB Cloud, so finding none or several items is an error.
+func findByName[T any](items []T, name, kind string, nameOf func(T) string) (*T, error) {
+	var matches []T
+	for _, item := range items {
+		if nameOf(item) == name {
+			matches = append(matches, item)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no %s named %q was found", kind, name)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("found %d %ss named %q, look it up by id instead", len(matches), kind, name)
+	}
+}
+
+// splitImportId splits a composite import identifier into its parts. The
+// format names the parts separated by slashes, e.g. "organization_id/user_id",
+// and every part must be non-empty.
+func splitImportId(id, format string) ([]string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != strings.Count(format, "/")+1 || slices.Contains(parts, "") {
+		return nil, fmt.Errorf("expected an import identifier of the form %s, got: %q", format, id)
+	}
+	return parts, nil
+}
+
+// decodeApiResponse reads the body of a raw API response and decodes it into
+// v. It is used instead of the generated typed client for payloads that the
+// typed client cannot parse, such as timestamps without a timezone offset.
+func decodeApiResponse(httpResponse *http.Response, wantStatus int, v any) error {
+	defer func() { _ = httpResponse.Body.Close() }()
+
+	body, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return fmt.Errorf("could not read response: %w", err)
+	}
+
+	if httpResponse.StatusCode != wantStatus {
+		return errors.New(apiErrorDetail(httpResponse, body))
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("could not parse response: %w\n%s", err, apiErrorDetail(httpResponse, body))
+	}
+	return nil
+}
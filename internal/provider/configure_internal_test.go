@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFindByName(t *testing.T) {
+	type item struct{ id, name string }
+	items := []item{{"1", "alpha"}, {"2", "beta"}, {"3", "beta"}}
+	nameOf := func(i item) string { return i.name }
+
+	testCases := map[string]struct {
+		name    string
+		wantID  string
+		wantErr string
+	}{
+		"unique":    {name: "alpha", wantID: "1"},
+		"missing":   {name: "gamma", wantErr: `no project named "gamma" was found`},
+		"ambiguous": {name: "beta", wantErr: `found 2 projects named "beta"`},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			found, err := findByName(items, testCase.name, "project", nameOf)
+			if testCase.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if found.id != testCase.wantID {
+				t.Errorf("got id %q, want %q", found.id, testCase.wantID)
+			}
+		})
+	}
+}
+
+func TestSplitImportId(t *testing.T) {
+	testCases := map[string]struct {
+		id        string
+		wantParts []string
+		wantError bool
+	}{
+		"valid":         {id: "org/user", wantParts: []string{"org", "user"}},
+		"too few parts": {id: "org", wantError: true},
+		"too many":      {id: "org/user/extra", wantError: true},
+		"empty part":    {id: "org/", wantError: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			parts, err := splitImportId(testCase.id, "organization_id/user_id")
+			if testCase.wantError {
+				if err == nil {
+					t.Fatalf("expected an error, got parts %v", parts)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(parts, testCase.wantParts) {
+				t.Errorf("got parts %v, want %v", parts, testCase.wantParts)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// Channels a cluster can be deployed from.
+var crateVersionChannels = []string{"stable", "testing", "nightly"}
+
+// apiCrateVersion mirrors cratedb.CrateDBVersion and
+// cratedb.NightlyCrateDBVersion.
+type apiCrateVersion struct {
+	Date    *string `json:"date"`
+	Hotfix  *int    `json:"hotfix"`
+	Major   *int    `json:"major"`
+	Minor   *int    `json:"minor"`
+	Version *string `json:"version"`
+}
+
+// apiCrateVersionList holds the versions of a channel. The API documents a
+// single version per channel, so both a single version and a list of
+// versions are accepted.
+type apiCrateVersionList []apiCrateVersion
+
+func (l *apiCrateVersionList) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.HasPrefix(data, []byte("[")) {
+		var versions []apiCrateVersion
+		if err := json.Unmarshal(data, &versions); err != nil {
+			return err
+		}
+		*l = versions
+		return nil
+	}
+
+	var version *apiCrateVersion
+	if err := json.Unmarshal(data, &version); err != nil {
+		return err
+	}
+	*l = nil
+	if version != nil {
+		*l = apiCrateVersionList{*version}
+	}
+	return nil
+}
+
+// apiCrateVersions mirrors cratedb.CrateDBVersions.
+type apiCrateVersions struct {
+	CrateVersions map[string]apiCrateVersionList `json:"crate_versions"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &CrateVersionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &CrateVersionsDataSource{}
+)
+
+// NewCrateVersionsDataSource is a helper function to simplify the provider implementation.
+func NewCrateVersionsDataSource() datasource.DataSource {
+	return &CrateVersionsDataSource{}
+}
+
+// CrateVersionsDataSource is the data source implementation.
+type CrateVersionsDataSource struct {
+	client *cratedb.ClientWithResponses
+}
+
+// CrateVersionsDataSourceModel describes the data source data model.
+type CrateVersionsDataSourceModel struct {
+	Channel       types.String        `tfsdk:"channel"`
+	VersionPrefix types.String        `tfsdk:"version_prefix"`
+	LatestVersion types.String        `tfsdk:"latest_version"`
+	Versions      []CrateVersionModel `tfsdk:"versions"`
+}
+
+// CrateVersionModel maps CrateDB version schema data.
+type CrateVersionModel struct {
+	Date    types.String `tfsdk:"date"`
+	Hotfix  types.Int64  `tfsdk:"hotfix"`
+	Major   types.Int64  `tfsdk:"major"`
+	Minor   types.Int64  `tfsdk:"minor"`
+	Version types.String `tfsdk:"version"`
+}
+
+// Metadata returns the data source type name.
+func (d *CrateVersionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_crate_versions"
+}
+
+// Schema defines the schema for the data source.
+func (d *CrateVersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "To retrieve the CrateDB versions a cluster can be deployed with, e.g. as `crate_version` of a `cratedb_cluster`.",
+
+		Attributes: map[string]schema.Attribute{
+			"channel": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The channel of the versions, one of `stable`, `testing` or `nightly`. Default is `stable`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(crateVersionChannels...),
+				},
+			},
+			"version_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return versions starting with this prefix, e.g. `5.` for the latest 5.x version or `5.10.` for the latest patch of 5.10.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"latest_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The newest of the returned versions. Not set when no version matches.",
+			},
+			"versions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The list of versions.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"date": schema.StringAttribute{
+							Computed:    true,
+							Description: "The build date of a nightly version.",
+						},
+						"hotfix": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The hotfix number of the version.",
+						},
+						"major": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The major number of the version.",
+						},
+						"minor": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The minor number of the version.",
+						},
+						"version": schema.StringAttribute{
+							Computed:    true,
+							Description: "The version, used as `crate_version` of a cluster.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *CrateVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := clientFromProviderData(req.ProviderData, "Data Source", &resp.Diagnostics); client != nil {
+		d.client = client
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *CrateVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state CrateVersionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Channel.IsNull() {
+		state.Channel = types.StringValue("stable")
+	}
+
+	channelVersions, err := listCrateVersions(ctx, d.client, state.Channel.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting CrateDB versions",
+			err.Error(),
+		)
+		return
+	}
+
+	versions := filterCrateVersions(channelVersions, state.VersionPrefix.ValueString())
+
+	state.LatestVersion = types.StringNull()
+	if latestVersion := latestCrateVersion(versions); latestVersion != "" {
+		state.LatestVersion = types.StringValue(latestVersion)
+	}
+
+	state.Versions = []CrateVersionModel{}
+	for _, version := range versions {
+		state.Versions = append(state.Versions, CrateVersionModel{
+			Date:    types.StringPointerValue(version.Date),
+			Hotfix:  intPointerToInt64Value(version.Hotfix),
+			Major:   intPointerToInt64Value(version.Major),
+			Minor:   intPointerToInt64Value(version.Minor),
+			Version: types.StringPointerValue(version.Version),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// listCrateVersions lists the CrateDB versions of a channel. The raw client is
+// used because the generated client only accepts a single version per channel.
+func listCrateVersions(ctx context.Context, client *cratedb.ClientWithResponses, channel string) ([]apiCrateVersion, error) {
+	crateVersionsResponse, err := client.GetApiV2MetaCratedbVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var crateVersions apiCrateVersions
+	if err := decodeApiResponse(crateVersionsResponse, http.StatusOK, &crateVersions); err != nil {
+		return nil, err
+	}
+	return crateVersions.CrateVersions[channel], nil
+}
+
+// filterCrateVersions returns the versions starting with the prefix.
+func filterCrateVersions(versions []apiCrateVersion, prefix string) []apiCrateVersion {
+	var filtered []apiCrateVersion
+	for _, version := range versions {
+		if version.Version != nil && strings.HasPrefix(*version.Version, prefix) {
+			filtered = append(filtered, version)
+		}
+	}
+	return filtered
+}
+
+// latestCrateVersion returns the newest of the versions, or an empty string
+// when there are none.
+func latestCrateVersion(versions []apiCrateVersion) string {
+	latest := ""
+	for _, version := range versions {
+		if version.Version == nil {
+			continue
+		}
+		if comparison, ok := compareCrateVersions(*version.Version, latest); latest == "" || (ok && comparison > 0) {
+			latest = *version.Version
+		}
+	}
+	return latest
+}
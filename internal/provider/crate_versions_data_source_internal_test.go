@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLatestCrateVersion(t *testing.T) {
+	// A channel holds a single version as documented, or a list of versions.
+	payload := `{"crate_versions": {
+		"stable": [
+			{"version": "5.9.12", "major": 5, "minor": 9, "hotfix": 12},
+			{"version": "5.10.9", "major": 5, "minor": 10, "hotfix": 9},
+			{"version": "5.10.11", "major": 5, "minor": 10, "hotfix": 11},
+			{"version": "4.8.4", "major": 4, "minor": 8, "hotfix": 4}
+		],
+		"testing": {"version": "6.0.0", "major": 6, "minor": 0, "hotfix": 0},
+		"nightly": null
+	}}`
+
+	var crateVersions apiCrateVersions
+	if err := json.Unmarshal([]byte(payload), &crateVersions); err != nil {
+		t.Fatalf("unmarshalling CrateDB versions payload: %v", err)
+	}
+
+	testCases := map[string]struct {
+		channel    string
+		prefix     string
+		wantCount  int
+		wantLatest string
+	}{
+		"stable":         {channel: "stable", wantCount: 4, wantLatest: "5.10.11"},
+		"major prefix":   {channel: "stable", prefix: "5.", wantCount: 3, wantLatest: "5.10.11"},
+		"minor prefix":   {channel: "stable", prefix: "5.9.", wantCount: 1, wantLatest: "5.9.12"},
+		"no match":       {channel: "stable", prefix: "7.", wantCount: 0, wantLatest: ""},
+		"single version": {channel: "testing", wantCount: 1, wantLatest: "6.0.0"},
+		"no version":     {channel: "nightly", wantCount: 0, wantLatest: ""},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			versions := filterCrateVersions(crateVersions.CrateVersions[testCase.channel], testCase.prefix)
+			if len(versions) != testCase.wantCount {
+				t.Errorf("expected %d versions, got %d", testCase.wantCount, len(versions))
+			}
+			if latest := latestCrateVersion(versions); latest != testCase.wantLatest {
+				t.Errorf("expected latest version %q, got %q", testCase.wantLatest, latest)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCrateVersionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + `
+data "cratedb_crate_versions" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cratedb_crate_versions.test", "channel", "stable"),
+					resource.TestMatchResourceAttr("data.cratedb_crate_versions.test", "latest_version", regexp.MustCompile(`^\d+\.\d+\.\d+$`)),
+					resource.TestCheckResourceAttrSet("data.cratedb_crate_versions.test", "versions.0.version"),
+				),
+			},
+		},
+	})
+}
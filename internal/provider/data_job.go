@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Compressions of the data of import and export jobs. CrateDB Cloud assumes
+// no compression when none is given.
+const (
+	dataJobCompressionGzip = "gzip"
+	dataJobCompressionNone = "none"
+)
+
+// dataJobFormats are the data formats of import and export jobs.
+var dataJobFormats = []string{"csv", "json", "parquet"}
+
+// Statuses of import and export jobs that are final.
+const (
+	dataJobSucceeded = "SUCCEEDED"
+	dataJobFailed    = "FAILED"
+)
+
+// dataJobPollInterval is how often an import or export job is polled while it
+// is running. It is a variable so tests can shorten it.
+var dataJobPollInterval = 10 * time.Second
+
+// dataJobState is the status and progress of an import or export job as
+// returned by the API.
+type dataJobState struct {
+	Status   string
+	Progress map[string]any
+}
+
+// waitForDataJob polls an import or export job through getJob until it
+// finished, logging its progress. kind names the job in messages, e.g.
+// "import job". It returns an error carrying the progress message when the
+// job failed or the timeout expired.
+func waitForDataJob(ctx context.Context, kind, jobId string, timeout time.Duration, getJob func(ctx context.Context) (*dataJobState, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ctx = tflog.SetField(ctx, "job_id", jobId)
+
+	ticker := time.NewTicker(dataJobPollInterval)
+	defer ticker.Stop()
+
+	for {
+		job, err := getJob(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("timed out after %s waiting for %s %s", timeout, kind, jobId)
+			}
+			return fmt.Errorf("could not read %s %s: %w", kind, jobId, err)
+		}
+
+		tflog.Info(ctx, "Polled "+kind, map[string]any{
+			"status":  job.Status,
+			"percent": job.Progress["percent"],
+			"records": job.Progress["records"],
+		})
+
+		switch job.Status {
+		case dataJobSucceeded:
+			return nil
+		case dataJobFailed:
+			message, _ := job.Progress["message"].(string)
+			if message == "" {
+				message = "no error details were reported by the API"
+			}
+			return fmt.Errorf("%s %s failed: %s", kind, jobId, message)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out after %s waiting for %s %s, last status %s", timeout, kind, jobId, job.Status)
+		case <-ticker.C:
+		}
+	}
+}
+
+// dataJobProgressInt64 returns a numeric progress value of an import or
+// export job, or null when the API did not report it. JSON numbers are
+// decoded as float64.
+func dataJobProgressInt64(progress map[string]any, key string) types.Int64 {
+	value, ok := progress[key].(float64)
+	if !ok {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(value))
+}
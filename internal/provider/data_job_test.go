@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+func TestWaitForImportJob(t *testing.T) {
+	dataJobPollInterval = time.Millisecond
+	t.Cleanup(func() { dataJobPollInterval = 10 * time.Second })
+
+	const (
+		clusterID   = "7e1c3a2e-0000-4000-8000-000000000001"
+		importJobID = "7e1c3a2e-0000-4000-8000-000000000002"
+	)
+
+	// Each case returns the import job payloads in order, repeating the last
+	// one. Job timestamps have no timezone offset, as returned by the live API.
+	testCases := map[string]struct {
+		importJobs  []string
+		wantErr     string
+		wantRecords int64
+	}{
+		"succeeded": {
+			importJobs: []string{
+				`{"id":"` + importJobID + `","status":"REGISTERED","format":"csv","type":"url","destination":{"table":"t"}}`,
+				`{"id":"` + importJobID + `","status":"IN_PROGRESS","format":"csv","type":"url","destination":{"table":"t"},"progress":{"percent":50.0,"records":10}}`,
+				`{"id":"` + importJobID + `","status":"SUCCEEDED","format":"csv","type":"url","destination":{"table":"t"},` +
+					`"dc":{"created":"2026-07-10T10:41:02.983000","modified":"2026-07-10T10:45:02.983000"},` +
+					`"progress":{"percent":100.0,"records":20,"failed_records":0}}`,
+			},
+			wantRecords: 20,
+		},
+		"failed with message": {
+			importJobs: []string{
+				`{"id":"` + importJobID + `","status":"FAILED","format":"csv","type":"url","destination":{"table":"t"},"progress":{"message":"file not found"}}`,
+			},
+			wantErr: "file not found",
+		},
+		"timed out": {
+			importJobs: []string{
+				`{"id":"` + importJobID + `","status":"IN_PROGRESS","format":"csv","type":"url","destination":{"table":"t"}}`,
+			},
+			wantErr: "timed out",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var polls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Path != "/api/v2/clusters/"+clusterID+"/import-jobs/"+importJobID+"/" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				poll := int(polls.Add(1)) - 1
+				_, _ = w.Write([]byte(testCase.importJobs[min(poll, len(testCase.importJobs)-1)]))
+			}))
+			defer server.Close()
+
+			client, err := cratedb.NewClientWithResponses(server.URL)
+			if err != nil {
+				t.Fatalf("creating client: %v", err)
+			}
+
+			importJob, err := waitForImportJob(context.Background(), client, clusterID, importJobID, 200*time.Millisecond)
+			if testCase.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if records := dataJobProgressInt64(importJob.Progress, "records"); records.ValueInt64() != testCase.wantRecords {
+				t.Errorf("expected %d records, got %s", testCase.wantRecords, records)
+			}
+		})
+	}
+}
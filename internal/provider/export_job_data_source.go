@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ExportJobDataSource{}
+	_ datasource.DataSourceWithConfigure = &ExportJobDataSource{}
+)
+
+// NewExportJobDataSource is a helper function to simplify the provider implementation.
+func NewExportJobDataSource() datasource.DataSource {
+	return &ExportJobDataSource{}
+}
+
+// ExportJobDataSource is the data source implementation.
+type ExportJobDataSource struct {
+	client *cratedb.ClientWithResponses
+}
+
+// Metadata returns the data source type name.
+func (d *ExportJobDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_export_job"
+}
+
+// Schema defines the schema for the data source.
+func (d *ExportJobDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "To retrieve an export job of a cluster, e.g. to get a fresh download URL of an export started outside of Terraform.",
+
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the cluster.",
+			},
+			"compression": schema.StringAttribute{
+				Computed:    true,
+				Description: "The compression of the exported file.",
+			},
+			"download_url": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The pre-signed URL to download the exported file from.",
+			},
+			"failed_records": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of records that could not be exported.",
+			},
+			"file_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The id of the exported file.",
+			},
+			"file_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the exported file.",
+			},
+			"file_size": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The size of the exported file in bytes.",
+			},
+			"format": schema.StringAttribute{
+				Computed:    true,
+				Description: "The format of the exported file.",
+			},
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the export job.",
+			},
+			"records": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of exported records.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the export job.",
+			},
+			"table": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the exported table.",
+			},
+			"dc": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The DublinCore of the export job.",
+				Attributes: map[string]schema.Attribute{
+					"created": schema.StringAttribute{
+						CustomType:  timetypes.RFC3339Type{},
+						Computed:    true,
+						Description: "The created time.",
+					},
+					"modified": schema.StringAttribute{
+						CustomType:  timetypes.RFC3339Type{},
+						Computed:    true,
+						Description: "The modified time.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ExportJobDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := clientFromProviderData(req.ProviderData, "Data Source", &resp.Diagnostics); client != nil {
+		d.client = client
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ExportJobDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ExportJobModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exportJob, err := getExportJob(ctx, d.client, state.ClusterId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting export job",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	exportJobState, err := getExportJobModel(ctx, state.ClusterId.ValueString(), *exportJob)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting export job model",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, exportJobState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// apiExportJob mirrors cratedb.ClusterExportJob with tolerant timestamp
+// decoding.
+type apiExportJob struct {
+	Compression *string                             `json:"compression"`
+	Dc          *apiDublinCore                      `json:"dc"`
+	Destination cratedb.ClusterExportJobDestination `json:"destination"`
+	Id          *string                             `json:"id"`
+	Progress    map[string]any                      `json:"progress"`
+	Source      cratedb.ClusterExportJobSource      `json:"source"`
+	Status      *string                             `json:"status"`
+}
+
+// ExportJobModel maps CrateDB cluster export job schema data.
+type ExportJobModel struct {
+	ClusterId     types.String `tfsdk:"cluster_id"`
+	Compression   types.String `tfsdk:"compression"`
+	Dc            types.Object `tfsdk:"dc"`
+	DownloadUrl   types.String `tfsdk:"download_url"`
+	FailedRecords types.Int64  `tfsdk:"failed_records"`
+	FileId        types.String `tfsdk:"file_id"`
+	FileName      types.String `tfsdk:"file_name"`
+	FileSize      types.Int64  `tfsdk:"file_size"`
+	Format        types.String `tfsdk:"format"`
+	Id            types.String `tfsdk:"id"`
+	Records       types.Int64  `tfsdk:"records"`
+	Status        types.String `tfsdk:"status"`
+	Table         types.String `tfsdk:"table"`
+}
+
+func getExportJobModel(ctx context.Context, clusterId string, exportJob apiExportJob) (*ExportJobModel, error) {
+	dcObjectValue, err := getDCObjectValue(ctx, exportJob.Dc.dublinCore())
+	if err != nil {
+		return nil, fmt.Errorf("error getting export job DC value: %w", err)
+	}
+
+	// The export file only exists once the job has written it.
+	downloadUrl := types.StringNull()
+	fileId := types.StringNull()
+	fileName := types.StringNull()
+	fileSize := types.Int64Null()
+	if file := exportJob.Destination.File; file != nil {
+		downloadUrl = types.StringPointerValue(file.DownloadUrl)
+		if file.Id != "" {
+			fileId = types.StringValue(file.Id)
+		}
+		fileName = types.StringPointerValue(file.Name)
+		fileSize = intPointerToInt64Value(file.FileSize)
+	}
+
+	// The API omits the default it applied.
+	compression := types.StringValue(dataJobCompressionNone)
+	if exportJob.Compression != nil {
+		compression = types.StringValue(*exportJob.Compression)
+	}
+
+	return &ExportJobModel{
+		ClusterId:     types.StringValue(clusterId),
+		Compression:   compression,
+		Dc:            dcObjectValue,
+		DownloadUrl:   downloadUrl,
+		FailedRecords: dataJobProgressInt64(exportJob.Progress, "failed_records"),
+		FileId:        fileId,
+		FileName:      fileName,
+		FileSize:      fileSize,
+		Format:        types.StringValue(exportJob.Destination.Format),
+		Id:            types.StringPointerValue(exportJob.Id),
+		Records:       dataJobProgressInt64(exportJob.Progress, "records"),
+		Status:        types.StringPointerValue(exportJob.Status),
+		Table:         types.StringValue(exportJob.Source.Table),
+	}, nil
+}
+
+// getExportJob reads an export job of a cluster through the raw client,
+// because the job timestamps are not always RFC3339.
+func getExportJob(ctx context.Context, client *cratedb.ClientWithResponses, clusterId, exportJobId string) (*apiExportJob, error) {
+	readExportJobResponse, err := client.GetApiV2ClustersClusterIdExportJobsExportJobId(ctx, clusterId, exportJobId)
+	if err != nil {
+		return nil, err
+	}
+
+	var exportJob apiExportJob
+	if err := decodeApiResponse(readExportJobResponse, http.StatusOK, &exportJob); err != nil {
+		return nil, err
+	}
+	return &exportJob, nil
+}
+
+// waitForExportJob polls an export job until it finished and returns it.
+func waitForExportJob(ctx context.Context, client *cratedb.ClientWithResponses, clusterId, exportJobId string, timeout time.Duration) (*apiExportJob, error) {
+	var exportJob *apiExportJob
+	err := waitForDataJob(ctx, "export job", exportJobId, timeout, func(ctx context.Context) (*dataJobState, error) {
+		job, err := getExportJob(ctx, client, clusterId, exportJobId)
+		if err != nil {
+			return nil, err
+		}
+		exportJob = job
+
+		status := ""
+		if job.Status != nil {
+			status = *job.Status
+		}
+		return &dataJobState{Status: status, Progress: job.Progress}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return exportJob, nil
+}
@@ -0,0 +1,410 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ExportJobResource{}
+	_ resource.ResourceWithConfigure   = &ExportJobResource{}
+	_ resource.ResourceWithImportState = &ExportJobResource{}
+)
+
+// defaultExportJobCreateTimeout bounds how long Create waits for the export
+// job to finish when no timeout is configured.
+const defaultExportJobCreateTimeout = 60 * time.Minute
+
+// NewExportJobResource is a helper function to simplify the provider implementation.
+func NewExportJobResource() resource.Resource {
+	return &ExportJobResource{}
+}
+
+// ExportJobResource defines the resource implementation.
+type ExportJobResource struct {
+	client *cratedb.ClientWithResponses
+}
+
+// ExportJobResourceModel describes the resource data model.
+type ExportJobResourceModel struct {
+	ExportJobModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *ExportJobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_export_job"
+}
+
+// Schema defines the schema for the resource.
+func (r *ExportJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Exports a table of a cluster to a file that can be downloaded from `download_url`. " +
+			"CrateDB Cloud writes exports to its own file storage; exporting directly to an object storage bucket is not supported by the API. " +
+			"The export runs once when the resource is created, and again whenever one of its arguments changes, which replaces the resource. " +
+			"Destroying the resource removes the job and its file from CrateDB Cloud, cancelling it if it is still running.",
+
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the cluster to export the table from.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"compression": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Default:     stringdefault.StaticString(dataJobCompressionNone),
+				Description: "The compression of the exported file, either `gzip` or `none`. Defaults to `none`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(dataJobCompressionGzip, dataJobCompressionNone),
+				},
+			},
+			"download_url": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The URL to download the exported file from. It is a pre-signed URL that grants access to the data and expires, so it is refreshed on every read.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"failed_records": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of records that could not be exported.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"file_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The id of the exported file.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"file_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the exported file.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"file_size": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The size of the exported file in bytes.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"format": schema.StringAttribute{
+				Required:    true,
+				Description: "The format of the exported file, one of `csv`, `json` or `parquet`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(dataJobFormats...),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The id of the export job.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"records": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of exported records.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the export job.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"table": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the table to export, optionally qualified with its schema.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dc": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The DublinCore of the export job.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"created": schema.StringAttribute{
+						CustomType:  timetypes.RFC3339Type{},
+						Computed:    true,
+						Description: "The created time.",
+					},
+					"modified": schema.StringAttribute{
+						CustomType:  timetypes.RFC3339Type{},
+						Computed:    true,
+						Description: "The modified time.",
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ExportJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ExportJobResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultExportJobCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	createExportJobRequest := cratedb.ClusterExportJob{
+		Compression: plan.Compression.ValueStringPointer(),
+		Destination: cratedb.ClusterExportJobDestination{
+			Format: plan.Format.ValueString(),
+		},
+		Source: cratedb.ClusterExportJobSource{
+			Table: plan.Table.ValueString(),
+		},
+	}
+	clusterId := plan.ClusterId.ValueString()
+
+	createExportJobResponse, err := r.client.PostApiV2ClustersClusterIdExportJobs(ctx, clusterId, createExportJobRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating export job",
+			"Could not create export job, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var exportJob apiExportJob
+	if err := decodeApiResponse(createExportJobResponse, http.StatusCreated, &exportJob); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating export job",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	exportJobPlan, err := getExportJobModel(ctx, clusterId, exportJob)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting export job model",
+			err.Error(),
+		)
+		return
+	}
+	plan.ExportJobModel = *exportJobPlan
+
+	// Save the export job into Terraform state before waiting, so a failed
+	// or timed out export leaves a tainted resource that is re-triggered.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Wait for the export to finish
+	finishedExportJob, err := waitForExportJob(ctx, r.client, clusterId, plan.Id.ValueString(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for export job",
+			"Could not export table, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	exportJobPlan, err = getExportJobModel(ctx, clusterId, *finishedExportJob)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting export job model",
+			err.Error(),
+		)
+		return
+	}
+	plan.ExportJobModel = *exportJobPlan
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ExportJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state ExportJobResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed export job value from API
+	readExportJobResponse, err := r.client.GetApiV2ClustersClusterIdExportJobsExportJobId(ctx, state.ClusterId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting export job",
+			"Could not read export job, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// If the export job no longer exists, its file is gone too. Remove it
+	// from state so Terraform plans a new export instead of failing the
+	// refresh.
+	if isNotFound(readExportJobResponse) {
+		_ = readExportJobResponse.Body.Close()
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var exportJob apiExportJob
+	if err := decodeApiResponse(readExportJobResponse, http.StatusOK, &exportJob); err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting export job",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	exportJobState, err := getExportJobModel(ctx, state.ClusterId.ValueString(), exportJob)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting export job model",
+			err.Error(),
+		)
+		return
+	}
+	// Overwrite items with refreshed state
+	state.ExportJobModel = *exportJobState
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Every argument of an export job forces a replacement, so only the timeouts
+// can change here.
+func (r *ExportJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ExportJobResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ExportJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ExportJobResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing export job, which cancels it when it is still running
+	deleteExportJobResponse, err := r.client.DeleteApiV2ClustersClusterIdExportJobsExportJobIdWithResponse(ctx, state.ClusterId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting export job",
+			"Could not delete export job, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// An export job already deleted out-of-band is fine: the desired outcome
+	// (no export job) is achieved.
+	if isNotFound(deleteExportJobResponse.HTTPResponse) {
+		return
+	}
+
+	if deleteExportJobResponse.StatusCode() != 204 {
+		resp.Diagnostics.AddError(
+			"Error deleting export job",
+			apiErrorDetail(deleteExportJobResponse.HTTPResponse, deleteExportJobResponse.Body),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ExportJobResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := clientFromProviderData(req.ProviderData, "Resource", &resp.Diagnostics); client != nil {
+		r.client = client
+	}
+}
+
+func (r *ExportJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Export jobs belong to a cluster, so the import identifier combines the
+	// cluster id and the export job id.
+	parts, err := splitImportId(req.ID, "cluster_id/export_job_id")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccExportJobResource exports a system table, which exists on every
+// cluster, and reads the job back through the data source.
+func TestAccExportJobResource(t *testing.T) {
+	clusterID := envOrSkip(t, "CRATEDB_CLUSTER_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig + fmt.Sprintf(`
+resource "cratedb_export_job" "test" {
+  cluster_id  = %q
+  table       = "sys.summits"
+  format      = "json"
+  compression = "gzip"
+}
+
+data "cratedb_export_job" "test" {
+  cluster_id = cratedb_export_job.test.cluster_id
+  id         = cratedb_export_job.test.id
+}
+`, clusterID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cratedb_export_job.test", "status", "SUCCEEDED"),
+					resource.TestCheckResourceAttrSet("cratedb_export_job.test", "download_url"),
+					resource.TestCheckResourceAttrSet("cratedb_export_job.test", "file_id"),
+					resource.TestCheckResourceAttrPair("data.cratedb_export_job.test", "file_id", "cratedb_export_job.test", "file_id"),
+					resource.TestCheckResourceAttr("data.cratedb_export_job.test", "table", "sys.summits"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cratedb_export_job.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIdFunc("cratedb_export_job.test", "cluster_id", "id"),
+				ImportStateVerify: true,
+				// The download URL is pre-signed anew on every read.
+				ImportStateVerifyIgnore: []string{"download_url", "timeouts"},
+			},
+		},
+	})
+}
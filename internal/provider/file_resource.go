@@ -0,0 +1,594 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &FileResource{}
+	_ resource.ResourceWithConfigure   = &FileResource{}
+	_ resource.ResourceWithImportState = &FileResource{}
+	_ resource.ResourceWithModifyPlan  = &FileResource{}
+)
+
+// fileStatusUploaded is the status of a file once its content is uploaded.
+const fileStatusUploaded = "UPLOADED"
+
+// defaultFileCreateTimeout bounds how long Create waits for the upload to be
+// registered when no timeout is configured.
+const defaultFileCreateTimeout = 10 * time.Minute
+
+// filePollInterval is how often a file is polled until CrateDB Cloud
+// registered its upload. It is a variable so tests can shorten it.
+var filePollInterval = 2 * time.Second
+
+// apiFile mirrors cratedb.File with tolerant timestamp decoding.
+type apiFile struct {
+	Dc        *apiDublinCore `json:"dc"`
+	FileSize  *int           `json:"file_size"`
+	Id        *string        `json:"id"`
+	Name      string         `json:"name"`
+	Status    *string        `json:"status"`
+	UploadUrl *string        `json:"upload_url"`
+}
+
+// NewFileResource is a helper function to simplify the provider implementation.
+func NewFileResource() resource.Resource {
+	return &FileResource{}
+}
+
+// FileResource defines the resource implementation.
+type FileResource struct {
+	client *cratedb.ClientWithResponses
+}
+
+// FileModel maps CrateDB file schema data.
+type FileModel struct {
+	ContentSha256  types.String   `tfsdk:"content_sha256"`
+	Dc             types.Object   `tfsdk:"dc"`
+	FileSize       types.Int64    `tfsdk:"file_size"`
+	Id             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	OrganizationId types.String   `tfsdk:"organization_id"`
+	Source         types.String   `tfsdk:"source"`
+	Status         types.String   `tfsdk:"status"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *FileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file"
+}
+
+// Schema defines the schema for the resource.
+func (r *FileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Uploads a local file to an organization, e.g. as the `file` source of a `cratedb_import_job`. " +
+			"The SHA-256 hash of the file is tracked in `content_sha256`, so changing the content of the file uploads it again, which replaces the resource. " +
+			"An imported file is assumed to match the local `source` and records its hash on the next apply.",
+
+		Attributes: map[string]schema.Attribute{
+			"content_sha256": schema.StringAttribute{
+				Computed:    true,
+				Description: "The hex-encoded SHA-256 hash of the uploaded content.",
+			},
+			"file_size": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The size of the file in bytes.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The id of the file.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The name of the file in CrateDB Cloud. Defaults to the base name of `source` when the file is uploaded, and is kept when `source` is moved later.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Required:    true,
+				Description: "The path of the local file to upload. Moving the file without changing its content does not upload it again.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the file.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dc": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The DublinCore of the file.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"created": schema.StringAttribute{
+						CustomType:  timetypes.RFC3339Type{},
+						Computed:    true,
+						Description: "The created time.",
+					},
+					"modified": schema.StringAttribute{
+						CustomType:  timetypes.RFC3339Type{},
+						Computed:    true,
+						Description: "The modified time.",
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *FileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan FileModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultFileCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The file may have changed since the plan was made, or was only known
+	// during apply.
+	contentSha256, fileSize, err := fileSha256(plan.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Error reading file",
+			"Could not read file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if !plan.ContentSha256.IsUnknown() && contentSha256 != plan.ContentSha256.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"File changed during apply",
+			fmt.Sprintf("The content of %s changed after the plan was made. Run terraform apply again to upload the current content.", plan.Source.ValueString()),
+		)
+		return
+	}
+	plan.ContentSha256 = types.StringValue(contentSha256)
+	plan.FileSize = types.Int64Value(fileSize)
+	if plan.Name.IsUnknown() {
+		plan.Name = types.StringValue(filepath.Base(plan.Source.ValueString()))
+	}
+
+	// Generate API request body from plan
+	size := int(fileSize)
+	createFileRequest := cratedb.File{
+		FileSize: &size,
+		Name:     plan.Name.ValueString(),
+	}
+
+	createFileResponse, err := r.client.PostApiV2OrganizationsOrganizationIdFiles(ctx, plan.OrganizationId.ValueString(), createFileRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating file",
+			"Could not create file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var file apiFile
+	if err := decodeApiResponse(createFileResponse, http.StatusCreated, &file); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating file",
+			err.Error(),
+		)
+		return
+	}
+
+	if file.Id == nil || file.UploadUrl == nil {
+		resp.Diagnostics.AddError(
+			"Error creating file",
+			"The API did not return the id and upload URL of the created file.",
+		)
+		return
+	}
+
+	// Save the file into Terraform state before uploading, so a failed
+	// upload leaves a tainted resource instead of an orphan.
+	plan.Id = types.StringPointerValue(file.Id)
+	plan.Status = types.StringPointerValue(file.Status)
+	plan.Dc, err = getDCObjectValue(ctx, file.Dc.dublinCore())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting file DC value",
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Upload the content to the pre-signed URL
+	if err := uploadFile(ctx, *file.UploadUrl, plan.Source.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error uploading file",
+			"Could not upload file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Wait for CrateDB Cloud to register the upload
+	uploadedFile, err := waitForFileUploaded(ctx, r.client, plan.OrganizationId.ValueString(), plan.Id.ValueString(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for file upload",
+			"Could not upload file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Status = types.StringPointerValue(uploadedFile.Status)
+	plan.Dc, err = getDCObjectValue(ctx, uploadedFile.Dc.dublinCore())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting file DC value",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *FileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state FileModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed file value from API
+	readFileResponse, err := r.client.GetApiV2OrganizationsOrganizationIdFilesFileId(ctx, state.OrganizationId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting file",
+			"Could not read file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// If the file no longer exists, remove it from state so Terraform plans
+	// a new upload instead of failing the refresh.
+	if isNotFound(readFileResponse) {
+		_ = readFileResponse.Body.Close()
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var file apiFile
+	if err := decodeApiResponse(readFileResponse, http.StatusOK, &file); err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting file",
+			err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state. The source and its hash only
+	// exist locally and are kept.
+	state.Dc, err = getDCObjectValue(ctx, file.Dc.dublinCore())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting file DC value",
+			err.Error(),
+		)
+		return
+	}
+	if file.FileSize != nil {
+		state.FileSize = intPointerToInt64Value(file.FileSize)
+	}
+	state.Name = types.StringValue(file.Name)
+	state.Status = types.StringPointerValue(file.Status)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// A changed name or content replaces the file, so only a moved source file
+// or the timeouts can change here.
+func (r *FileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan FileModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *FileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state FileModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing file
+	deleteFileResponse, err := r.client.DeleteApiV2OrganizationsOrganizationIdFilesFileIdWithResponse(ctx, state.OrganizationId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting file",
+			"Could not delete file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// A file already deleted out-of-band is fine: the desired outcome (no
+	// file) is achieved.
+	if isNotFound(deleteFileResponse.HTTPResponse) {
+		return
+	}
+
+	if deleteFileResponse.StatusCode() != 204 {
+		resp.Diagnostics.AddError(
+			"Error deleting file",
+			apiErrorDetail(deleteFileResponse.HTTPResponse, deleteFileResponse.Body),
+		)
+		return
+	}
+}
+
+// ModifyPlan hashes the local file, defaults the name to its base name and
+// replaces the file when either changed.
+func (r *FileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan FileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *FileModel
+	if !req.State.Raw.IsNull() {
+		state = &FileModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The source may only be known after another resource was applied. Its
+	// content can then only be hashed by uploading it again.
+	if plan.Source.IsUnknown() {
+		if state != nil {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("source"))
+			plan.ContentSha256 = types.StringUnknown()
+			plan.FileSize = types.Int64Unknown()
+			plan.Id = types.StringUnknown()
+			plan.Status = types.StringUnknown()
+			plan.Dc = types.ObjectUnknown(DCModel{}.GetAttrType())
+			resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		}
+		return
+	}
+
+	// The name of an existing file is kept from state, so only a new file
+	// defaults to the base name of its source.
+	if plan.Name.IsUnknown() {
+		plan.Name = types.StringValue(filepath.Base(plan.Source.ValueString()))
+	}
+
+	contentSha256, fileSize, err := fileSha256(plan.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Error reading file",
+			"Could not read file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.ContentSha256 = types.StringValue(contentSha256)
+	plan.FileSize = types.Int64Value(fileSize)
+
+	if state != nil {
+		// An imported file has no recorded hash yet and adopts the one of
+		// the local file.
+		if !state.ContentSha256.IsNull() && !plan.ContentSha256.Equal(state.ContentSha256) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_sha256"))
+		}
+		if !plan.Name.Equal(state.Name) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("name"))
+		}
+		if len(resp.RequiresReplace) > 0 {
+			plan.Id = types.StringUnknown()
+			plan.Status = types.StringUnknown()
+			plan.Dc = types.ObjectUnknown(DCModel{}.GetAttrType())
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *FileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := clientFromProviderData(req.ProviderData, "Resource", &resp.Diagnostics); client != nil {
+		r.client = client
+	}
+}
+
+func (r *FileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Files belong to an organization, so the import identifier combines the
+	// organization id and the file id.
+	parts, err := splitImportId(req.ID, "organization_id/file_id")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+// fileSha256 returns the hex-encoded SHA-256 hash and the size of a local
+// file.
+func fileSha256(name string) (string, int64, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", 0, err
+	}
+	defer func() { _ = f.Close() }()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+// uploadFile uploads a local file to a pre-signed URL. The URL carries its
+// own authorization, so the request must not go through the API client, which
+// would add the provider credentials.
+func uploadFile(ctx context.Context, uploadUrl, name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadUrl, f)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = info.Size()
+
+	uploadResponse, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = uploadResponse.Body.Close() }()
+
+	if uploadResponse.StatusCode < 200 || uploadResponse.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(uploadResponse.Body, 4096))
+		return fmt.Errorf("upload returned %s: %s", uploadResponse.Status, body)
+	}
+	return nil
+}
+
+// waitForFileUploaded polls a file until CrateDB Cloud registered its upload
+// and returns it.
+func waitForFileUploaded(ctx context.Context, client *cratedb.ClientWithResponses, organizationId, fileId string, timeout time.Duration) (*apiFile, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ctx = tflog.SetField(ctx, "file_id", fileId)
+
+	ticker := time.NewTicker(filePollInterval)
+	defer ticker.Stop()
+
+	for {
+		readFileResponse, err := client.GetApiV2OrganizationsOrganizationIdFilesFileId(ctx, organizationId, fileId)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("timed out after %s waiting for the upload of file %s", timeout, fileId)
+			}
+			return nil, fmt.Errorf("could not read file %s: %w", fileId, err)
+		}
+
+		var file apiFile
+		if err := decodeApiResponse(readFileResponse, http.StatusOK, &file); err != nil {
+			return nil, fmt.Errorf("could not read file %s: %w", fileId, err)
+		}
+
+		tflog.Debug(ctx, "Polled file", map[string]any{"status": file.Status})
+		if file.Status != nil && *file.Status == fileStatusUploaded {
+			return &file, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out after %s waiting for the upload of file %s", timeout, fileId)
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestFileSha256(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "data.csv")
+	if err := os.WriteFile(fileName, []byte("id,name\n1,crate\n"), 0o600); err != nil {
+		t.Fatalf("writing file: %v", err)
+	}
+
+	contentSha256, size, err := fileSha256(fileName)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "a052f7b0f36bb3c95d462bf678780bd873a16484757fcc97959816e97c256ebe"; contentSha256 != want {
+		t.Errorf("expected hash %q, got %q", want, contentSha256)
+	}
+	if size != 16 {
+		t.Errorf("expected size 16, got %d", size)
+	}
+
+	if _, _, err := fileSha256(filepath.Join(t.TempDir(), "missing.csv")); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
+
+func TestUploadFile(t *testing.T) {
+	const content = "id,name\n1,crate\n"
+
+	fileName := filepath.Join(t.TempDir(), "data.csv")
+	if err := os.WriteFile(fileName, []byte(content), 0o600); err != nil {
+		t.Fatalf("writing file: %v", err)
+	}
+
+	testCases := map[string]struct {
+		status  int
+		wantErr bool
+	}{
+		"uploaded": {status: http.StatusOK},
+		"rejected": {status: http.StatusForbidden, wantErr: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				switch {
+				case r.Method != http.MethodPut:
+					t.Errorf("expected a PUT request, got %s", r.Method)
+				case r.Header.Get("Authorization") != "":
+					t.Error("expected no credentials to be sent to the pre-signed URL")
+				case string(body) != content:
+					t.Errorf("expected the file content to be uploaded, got %q", body)
+				}
+				w.WriteHeader(testCase.status)
+			}))
+			defer server.Close()
+
+			err := uploadFile(context.Background(), server.URL+"/upload?signature=abc", fileName)
+			if (err != nil) != testCase.wantErr {
+				t.Fatalf("uploadFile() error = %v, wantErr %t", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestFileModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := &FileResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	fileSchema := schemaResp.Schema
+
+	directory := t.TempDir()
+	source := filepath.Join(directory, "data.csv")
+	movedSource := filepath.Join(directory, "moved.csv")
+	for _, fileName := range []string{source, movedSource} {
+		if err := os.WriteFile(fileName, []byte("id,name\n1,crate\n"), 0o600); err != nil {
+			t.Fatalf("writing file: %v", err)
+		}
+	}
+	changedSource := filepath.Join(directory, "changed.csv")
+	if err := os.WriteFile(changedSource, []byte("id,name\n2,cloud\n"), 0o600); err != nil {
+		t.Fatalf("writing file: %v", err)
+	}
+	const contentSha256 = "a052f7b0f36bb3c95d462bf678780bd873a16484757fcc97959816e97c256ebe"
+
+	uploaded := map[string]attr.Value{
+		"content_sha256":  types.StringValue(contentSha256),
+		"file_size":       types.Int64Value(16),
+		"id":              types.StringValue("file-1"),
+		"name":            types.StringValue("data.csv"),
+		"organization_id": types.StringValue("org-1"),
+		"source":          types.StringValue(source),
+		"status":          types.StringValue("UPLOADED"),
+	}
+	// planned returns the plan of the uploaded file after the attribute plan
+	// modifiers ran, with the given attributes changed.
+	planned := func(values map[string]attr.Value) map[string]attr.Value {
+		plan := map[string]attr.Value{
+			"content_sha256": types.StringUnknown(),
+			"file_size":      types.Int64Unknown(),
+		}
+		for name, value := range uploaded {
+			if _, ok := plan[name]; !ok {
+				plan[name] = value
+			}
+		}
+		for name, value := range values {
+			plan[name] = value
+		}
+		return plan
+	}
+
+	testCases := map[string]struct {
+		state             map[string]attr.Value
+		plan              map[string]attr.Value
+		wantReplace       bool
+		wantName          types.String
+		wantContentSha256 types.String
+		wantFileSize      types.Int64
+	}{
+		"new file": {
+			plan: map[string]attr.Value{
+				"content_sha256":  types.StringUnknown(),
+				"file_size":       types.Int64Unknown(),
+				"id":              types.StringUnknown(),
+				"name":            types.StringUnknown(),
+				"organization_id": types.StringValue("org-1"),
+				"source":          types.StringValue(source),
+			},
+			wantName:          types.StringValue("data.csv"),
+			wantContentSha256: types.StringValue(contentSha256),
+			wantFileSize:      types.Int64Value(16),
+		},
+		"unchanged": {
+			state:             uploaded,
+			plan:              planned(nil),
+			wantName:          types.StringValue("data.csv"),
+			wantContentSha256: types.StringValue(contentSha256),
+			wantFileSize:      types.Int64Value(16),
+		},
+		"moved source": {
+			state:             uploaded,
+			plan:              planned(map[string]attr.Value{"source": types.StringValue(movedSource)}),
+			wantName:          types.StringValue("data.csv"),
+			wantContentSha256: types.StringValue(contentSha256),
+			wantFileSize:      types.Int64Value(16),
+		},
+		"changed content": {
+			state:             uploaded,
+			plan:              planned(map[string]attr.Value{"source": types.StringValue(changedSource)}),
+			wantReplace:       true,
+			wantName:          types.StringValue("data.csv"),
+			wantContentSha256: types.StringValue("19418b11545916ccfbda51671f25e01c68aa654dfc418615d2a0d7eaff01709b"),
+			wantFileSize:      types.Int64Value(16),
+		},
+		"unknown source": {
+			state:             uploaded,
+			plan:              planned(map[string]attr.Value{"source": types.StringUnknown()}),
+			wantReplace:       true,
+			wantName:          types.StringValue("data.csv"),
+			wantContentSha256: types.StringUnknown(),
+			wantFileSize:      types.Int64Unknown(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				Plan:  tfsdk.Plan{Schema: fileSchema, Raw: testResourceValue(t, fileSchema, testCase.plan)},
+				State: tfsdk.State{Schema: fileSchema, Raw: testResourceValue(t, fileSchema, testCase.state)},
+			}
+			if testCase.state == nil {
+				req.State.Raw = tftypes.NewValue(fileSchema.Type().TerraformType(ctx), nil)
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if gotReplace := len(resp.RequiresReplace) > 0; gotReplace != testCase.wantReplace {
+				t.Errorf("expected replace %t, got %v", testCase.wantReplace, resp.RequiresReplace)
+			}
+
+			var plan FileModel
+			resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !plan.Name.Equal(testCase.wantName) {
+				t.Errorf("expected name %s, got %s", testCase.wantName, plan.Name)
+			}
+			if !plan.ContentSha256.Equal(testCase.wantContentSha256) {
+				t.Errorf("expected content_sha256 %s, got %s", testCase.wantContentSha256, plan.ContentSha256)
+			}
+			if !plan.FileSize.Equal(testCase.wantFileSize) {
+				t.Errorf("expected file_size %s, got %s", testCase.wantFileSize, plan.FileSize)
+			}
+		})
+	}
+}
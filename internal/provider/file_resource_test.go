@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+// TestAccFileResource uploads a small CSV file and then changes its content,
+// which must upload it again.
+func TestAccFileResource(t *testing.T) {
+	organizationID := envOrSkip(t, "CRATEDB_ORGANIZATION_ID")
+	source := filepath.Join(t.TempDir(), "tf-acc-test.csv")
+
+	writeSource := func(content string) {
+		if err := os.WriteFile(source, []byte(content), 0o600); err != nil {
+			t.Fatalf("writing %s: %v", source, err)
+		}
+	}
+	writeSource("id,name\n1,crate\n")
+
+	config := testAccProviderConfig + fmt.Sprintf(`
+resource "cratedb_file" "test" {
+  organization_id = %q
+  source          = %q
+}
+`, organizationID, source)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cratedb_file.test", "name", "tf-acc-test.csv"),
+					resource.TestCheckResourceAttr("cratedb_file.test", "status", "UPLOADED"),
+					resource.TestCheckResourceAttr("cratedb_file.test", "file_size", "16"),
+					resource.TestCheckResourceAttr("cratedb_file.test", "content_sha256", "a052f7b0f36bb3c95d462bf678780bd873a16484757fcc97959816e97c256ebe"),
+					resource.TestCheckResourceAttrSet("cratedb_file.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cratedb_file.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIdFunc("cratedb_file.test", "organization_id", "id"),
+				ImportStateVerify: true,
+				// The source and its hash only exist locally.
+				ImportStateVerifyIgnore: []string{"content_sha256", "source", "timeouts"},
+			},
+			// Changed content testing
+			{
+				PreConfig: func() { writeSource("id,name\n1,crate\n2,cloud\n") },
+				Config:    config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("cratedb_file.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cratedb_file.test", "file_size", "24"),
+				),
+			},
+		},
+	})
+}
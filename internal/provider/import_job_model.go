@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// Source types of an import job. Each matches the attribute holding the
+// source details.
+const (
+	importJobTypeAzureBlob = "azureblob"
+	importJobTypeFile      = "file"
+	importJobTypeS3        = "s3"
+	importJobTypeUrl       = "url"
+)
+
+// apiImportJob mirrors cratedb.ClusterImportJob with tolerant timestamp
+// decoding.
+type apiImportJob struct {
+	Azureblob   *cratedb.ClusterDataJobAzureBlob    `json:"azureblob"`
+	Compression *string                             `json:"compression"`
+	Dc          *apiDublinCore                      `json:"dc"`
+	Destination cratedb.ClusterImportJobDestination `json:"destination"`
+	File        *cratedb.ClusterDataJobFile         `json:"file"`
+	Format      string                              `json:"format"`
+	Id          *string                             `json:"id"`
+	Progress    map[string]any                      `json:"progress"`
+	S3          *cratedb.ClusterDataJobS3           `json:"s3"`
+	Status      *string                             `json:"status"`
+	Type        string                              `json:"type"`
+	Url         *cratedb.ClusterImportJobSourceURL  `json:"url"`
+}
+
+// ImportJobModel maps CrateDB cluster import job schema data.
+type ImportJobModel struct {
+	Azureblob     types.Object   `tfsdk:"azureblob"`
+	ClusterId     types.String   `tfsdk:"cluster_id"`
+	Compression   types.String   `tfsdk:"compression"`
+	CreateTable   types.Bool     `tfsdk:"create_table"`
+	Dc            types.Object   `tfsdk:"dc"`
+	FailedRecords types.Int64    `tfsdk:"failed_records"`
+	File          types.Object   `tfsdk:"file"`
+	Format        types.String   `tfsdk:"format"`
+	Id            types.String   `tfsdk:"id"`
+	Records       types.Int64    `tfsdk:"records"`
+	S3            types.Object   `tfsdk:"s3"`
+	Status        types.String   `tfsdk:"status"`
+	Table         types.String   `tfsdk:"table"`
+	Url           types.Object   `tfsdk:"url"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ImportJobAzureBlobModel maps CrateDB import job Azure Blob Storage source
+// schema data.
+type ImportJobAzureBlobModel struct {
+	BlobName      types.String `tfsdk:"blob_name"`
+	ContainerName types.String `tfsdk:"container_name"`
+	SecretId      types.String `tfsdk:"secret_id"`
+}
+
+func (i ImportJobAzureBlobModel) GetAttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"blob_name":      types.StringType,
+		"container_name": types.StringType,
+		"secret_id":      types.StringType,
+	}
+}
+
+// ImportJobFileModel maps CrateDB import job uploaded file source schema data.
+type ImportJobFileModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
+func (i ImportJobFileModel) GetAttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id": types.StringType,
+	}
+}
+
+// ImportJobS3Model maps CrateDB import job S3 source schema data.
+type ImportJobS3Model struct {
+	Bucket   types.String `tfsdk:"bucket"`
+	Endpoint types.String `tfsdk:"endpoint"`
+	FilePath types.String `tfsdk:"file_path"`
+	SecretId types.String `tfsdk:"secret_id"`
+}
+
+func (i ImportJobS3Model) GetAttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"bucket":    types.StringType,
+		"endpoint":  types.StringType,
+		"file_path": types.StringType,
+		"secret_id": types.StringType,
+	}
+}
+
+// ImportJobUrlModel maps CrateDB import job URL source schema data.
+type ImportJobUrlModel struct {
+	Url types.String `tfsdk:"url"`
+}
+
+func (i ImportJobUrlModel) GetAttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"url": types.StringType,
+	}
+}
+
+// getImportJobRequest converts the planned import job to the API request
+// body. The job type is derived from the configured source.
+func getImportJobRequest(ctx context.Context, plan ImportJobModel) (*cratedb.ClusterImportJob, error) {
+	importJobRequest := &cratedb.ClusterImportJob{
+		Compression: plan.Compression.ValueStringPointer(),
+		Destination: cratedb.ClusterImportJobDestination{
+			CreateTable: plan.CreateTable.ValueBoolPointer(),
+			Table:       plan.Table.ValueString(),
+		},
+		Format: plan.Format.ValueString(),
+	}
+
+	switch {
+	case !plan.Azureblob.IsNull():
+		var azureBlobValue ImportJobAzureBlobModel
+		if diags := plan.Azureblob.As(ctx, &azureBlobValue, basetypes.ObjectAsOptions{}); diags.HasError() {
+			return nil, fmt.Errorf("error converting azureblob value: %v", diags.Errors())
+		}
+		importJobRequest.Type = importJobTypeAzureBlob
+		importJobRequest.Azureblob = &cratedb.ClusterDataJobAzureBlob{
+			BlobName:      azureBlobValue.BlobName.ValueString(),
+			ContainerName: azureBlobValue.ContainerName.ValueString(),
+			SecretId:      azureBlobValue.SecretId.ValueString(),
+		}
+	case !plan.File.IsNull():
+		var fileValue ImportJobFileModel
+		if diags := plan.File.As(ctx, &fileValue, basetypes.ObjectAsOptions{}); diags.HasError() {
+			return nil, fmt.Errorf("error converting file value: %v", diags.Errors())
+		}
+		importJobRequest.Type = importJobTypeFile
+		importJobRequest.File = &cratedb.ClusterDataJobFile{
+			Id: fileValue.Id.ValueString(),
+		}
+	case !plan.S3.IsNull():
+		var s3Value ImportJobS3Model
+		if diags := plan.S3.As(ctx, &s3Value, basetypes.ObjectAsOptions{}); diags.HasError() {
+			return nil, fmt.Errorf("error converting s3 value: %v", diags.Errors())
+		}
+		importJobRequest.Type = importJobTypeS3
+		importJobRequest.S3 = &cratedb.ClusterDataJobS3{
+			Bucket:   s3Value.Bucket.ValueString(),
+			Endpoint: s3Value.Endpoint.ValueStringPointer(),
+			FilePath: s3Value.FilePath.ValueString(),
+			SecretId: s3Value.SecretId.ValueString(),
+		}
+	case !plan.Url.IsNull():
+		var urlValue ImportJobUrlModel
+		if diags := plan.Url.As(ctx, &urlValue, basetypes.ObjectAsOptions{}); diags.HasError() {
+			return nil, fmt.Errorf("error converting url value: %v", diags.Errors())
+		}
+		importJobRequest.Type = importJobTypeUrl
+		importJobRequest.Url = &cratedb.ClusterImportJobSourceURL{
+			Url: urlValue.Url.ValueString(),
+		}
+	default:
+		return nil, errors.New("no import job source is configured")
+	}
+
+	return importJobRequest, nil
+}
+
+func getImportJobModel(ctx context.Context, clusterId string, importJob apiImportJob) (*ImportJobModel, error) {
+	dcObjectValue, err := getDCObjectValue(ctx, importJob.Dc.dublinCore())
+	if err != nil {
+		return nil, fmt.Errorf("error getting import job DC value: %w", err)
+	}
+
+	azureBlobObjectValue := types.ObjectNull(ImportJobAzureBlobModel{}.GetAttrType())
+	if importJob.Azureblob != nil {
+		azureBlobValue := ImportJobAzureBlobModel{
+			BlobName:      types.StringValue(importJob.Azureblob.BlobName),
+			ContainerName: types.StringValue(importJob.Azureblob.ContainerName),
+			SecretId:      types.StringValue(importJob.Azureblob.SecretId),
+		}
+
+		azureBlobObject, diags := types.ObjectValueFrom(ctx, azureBlobValue.GetAttrType(), azureBlobValue)
+		if diags.HasError() {
+			return nil, fmt.Errorf("error getting import job azureblob value: %v", diags.Errors())
+		}
+		azureBlobObjectValue = azureBlobObject
+	}
+
+	fileObjectValue := types.ObjectNull(ImportJobFileModel{}.GetAttrType())
+	if importJob.File != nil {
+		fileValue := ImportJobFileModel{
+			Id: types.StringValue(importJob.File.Id),
+		}
+
+		fileObject, diags := types.ObjectValueFrom(ctx, fileValue.GetAttrType(), fileValue)
+		if diags.HasError() {
+			return nil, fmt.Errorf("error getting import job file value: %v", diags.Errors())
+		}
+		fileObjectValue = fileObject
+	}
+
+	s3ObjectValue := types.ObjectNull(ImportJobS3Model{}.GetAttrType())
+	if importJob.S3 != nil {
+		s3Value := ImportJobS3Model{
+			Bucket:   types.StringValue(importJob.S3.Bucket),
+			Endpoint: types.StringPointerValue(importJob.S3.Endpoint),
+			FilePath: types.StringValue(importJob.S3.FilePath),
+			SecretId: types.StringValue(importJob.S3.SecretId),
+		}
+
+		s3Object, diags := types.ObjectValueFrom(ctx, s3Value.GetAttrType(), s3Value)
+		if diags.HasError() {
+			return nil, fmt.Errorf("error getting import job s3 value: %v", diags.Errors())
+		}
+		s3ObjectValue = s3Object
+	}
+
+	urlObjectValue := types.ObjectNull(ImportJobUrlModel{}.GetAttrType())
+	if importJob.Url != nil {
+		urlValue := ImportJobUrlModel{
+			Url: types.StringValue(importJob.Url.Url),
+		}
+
+		urlObject, diags := types.ObjectValueFrom(ctx, urlValue.GetAttrType(), urlValue)
+		if diags.HasError() {
+			return nil, fmt.Errorf("error getting import job url value: %v", diags.Errors())
+		}
+		urlObjectValue = urlObject
+	}
+
+	// The API omits the defaults it applied.
+	compression := types.StringValue(dataJobCompressionNone)
+	if importJob.Compression != nil {
+		compression = types.StringValue(*importJob.Compression)
+	}
+	createTable := types.BoolValue(true)
+	if importJob.Destination.CreateTable != nil {
+		createTable = types.BoolValue(*importJob.Destination.CreateTable)
+	}
+
+	return &ImportJobModel{
+		Azureblob:     azureBlobObjectValue,
+		ClusterId:     types.StringValue(clusterId),
+		Compression:   compression,
+		CreateTable:   createTable,
+		Dc:            dcObjectValue,
+		FailedRecords: dataJobProgressInt64(importJob.Progress, "failed_records"),
+		File:          fileObjectValue,
+		Format:        types.StringValue(importJob.Format),
+		Id:            types.StringPointerValue(importJob.Id),
+		Records:       dataJobProgressInt64(importJob.Progress, "records"),
+		S3:            s3ObjectValue,
+		Status:        types.StringPointerValue(importJob.Status),
+		Table:         types.StringValue(importJob.Destination.Table),
+		Url:           urlObjectValue,
+	}, nil
+}
+
+// getImportJob reads an import job of a cluster through the raw client,
+// because the job timestamps are not always RFC3339.
+func getImportJob(ctx context.Context, client *cratedb.ClientWithResponses, clusterId, importJobId string) (*apiImportJob, error) {
+	readImportJobResponse, err := client.GetApiV2ClustersClusterIdImportJobsImportJobId(ctx, clusterId, importJobId)
+	if err != nil {
+		return nil, err
+	}
+
+	var importJob apiImportJob
+	if err := decodeApiResponse(readImportJobResponse, http.StatusOK, &importJob); err != nil {
+		return nil, err
+	}
+	return &importJob, nil
+}
+
+// waitForImportJob polls an import job until it finished and returns it.
+func waitForImportJob(ctx context.Context, client *cratedb.ClientWithResponses, clusterId, importJobId string, timeout time.Duration) (*apiImportJob, error) {
+	var importJob *apiImportJob
+	err := waitForDataJob(ctx, "import job", importJobId, timeout, func(ctx context.Context) (*dataJobState, error) {
+		job, err := getImportJob(ctx, client, clusterId, importJobId)
+		if err != nil {
+			return nil, err
+		}
+		importJob = job
+
+		status := ""
+		if job.Status != nil {
+			status = *job.Status
+		}
+		return &dataJobState{Status: status, Progress: job.Progress}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return importJob, nil
+}
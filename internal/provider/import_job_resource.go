@@ -0,0 +1,471 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ImportJobResource{}
+	_ resource.ResourceWithConfigure   = &ImportJobResource{}
+	_ resource.ResourceWithImportState = &ImportJobResource{}
+)
+
+// defaultImportJobCreateTimeout bounds how long Create waits for the import
+// job to finish when no timeout is configured.
+const defaultImportJobCreateTimeout = 60 * time.Minute
+
+// NewImportJobResource is a helper function to simplify the provider implementation.
+func NewImportJobResource() resource.Resource {
+	return &ImportJobResource{}
+}
+
+// ImportJobResource defines the resource implementation.
+type ImportJobResource struct {
+	client *cratedb.ClientWithResponses
+}
+
+// Metadata returns the resource type name.
+func (r *ImportJobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_import_job"
+}
+
+// Schema defines the schema for the resource.
+func (r *ImportJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Imports data into a table of a cluster from a URL, an S3 bucket, an Azure Blob Storage container or an uploaded file. " +
+			"The import runs once when the resource is created, and again whenever one of its arguments changes, which replaces the resource. " +
+			"Destroying the resource removes the job from CrateDB Cloud, cancelling it if it is still running, but keeps the imported data.",
+
+		Attributes: map[string]schema.Attribute{
+			"azureblob": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Imports a blob of an Azure Blob Storage container. Exactly one of `azureblob`, `file`, `s3` and `url` must be set.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(
+						path.MatchRoot("file"),
+						path.MatchRoot("s3"),
+						path.MatchRoot("url"),
+					),
+				},
+				Attributes: map[string]schema.Attribute{
+					"blob_name": schema.StringAttribute{
+						Required:    true,
+						Description: "The name of the blob, which may contain wildcards to import several blobs.",
+					},
+					"container_name": schema.StringAttribute{
+						Required:    true,
+						Description: "The name of the container.",
+					},
+					"secret_id": schema.StringAttribute{
+						Required:    true,
+						Description: "The id of the `AZURE` organization secret used to access the container.",
+					},
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the cluster to import the data into.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"compression": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Default:     stringdefault.StaticString(dataJobCompressionNone),
+				Description: "The compression of the imported data, either `gzip` or `none`. Defaults to `none`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(dataJobCompressionGzip, dataJobCompressionNone),
+				},
+			},
+			"create_table": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the table is created when it does not exist. Defaults to `true`.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"failed_records": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of records that could not be imported.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"file": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Imports a file uploaded to the organization. Exactly one of `azureblob`, `file`, `s3` and `url` must be set.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Required:    true,
+						Description: "The id of the uploaded file.",
+					},
+				},
+			},
+			"format": schema.StringAttribute{
+				Required:    true,
+				Description: "The format of the imported data, one of `csv`, `json` or `parquet`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(dataJobFormats...),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The id of the import job.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"records": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of imported records.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"s3": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Imports a file of an S3 bucket. Exactly one of `azureblob`, `file`, `s3` and `url` must be set.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"bucket": schema.StringAttribute{
+						Required:    true,
+						Description: "The name of the bucket.",
+					},
+					"endpoint": schema.StringAttribute{
+						Optional:    true,
+						Description: "The endpoint of an S3-compatible object storage. Defaults to AWS S3.",
+					},
+					"file_path": schema.StringAttribute{
+						Required:    true,
+						Description: "The path of the file in the bucket, which may contain wildcards to import several files.",
+					},
+					"secret_id": schema.StringAttribute{
+						Required:    true,
+						Description: "The id of the `AWS` organization secret used to access the bucket.",
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the import job.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"table": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the table to import the data into.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Imports a file from a URL. Exactly one of `azureblob`, `file`, `s3` and `url` must be set.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						Required:    true,
+						Description: "The URL of the file.",
+					},
+				},
+			},
+			"dc": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The DublinCore of the import job.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"created": schema.StringAttribute{
+						CustomType:  timetypes.RFC3339Type{},
+						Computed:    true,
+						Description: "The created time.",
+					},
+					"modified": schema.StringAttribute{
+						CustomType:  timetypes.RFC3339Type{},
+						Computed:    true,
+						Description: "The modified time.",
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ImportJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ImportJobModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultImportJobCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	createImportJobRequest, err := getImportJobRequest(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating import job",
+			"Could not create import job, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	clusterId := plan.ClusterId.ValueString()
+	importJobTimeouts := plan.Timeouts
+
+	createImportJobResponse, err := r.client.PostApiV2ClustersClusterIdImportJobs(ctx, clusterId, *createImportJobRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating import job",
+			"Could not create import job, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var importJob apiImportJob
+	if err := decodeApiResponse(createImportJobResponse, http.StatusCreated, &importJob); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating import job",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	importJobPlan, err := getImportJobModel(ctx, clusterId, importJob)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting import job model",
+			err.Error(),
+		)
+		return
+	}
+	plan = *importJobPlan
+	plan.Timeouts = importJobTimeouts
+
+	// Save the import job into Terraform state before waiting, so a failed
+	// or timed out import leaves a tainted resource that is re-triggered.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Wait for the import to finish
+	finishedImportJob, err := waitForImportJob(ctx, r.client, clusterId, plan.Id.ValueString(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for import job",
+			"Could not import data, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	importJobPlan, err = getImportJobModel(ctx, clusterId, *finishedImportJob)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting import job model",
+			err.Error(),
+		)
+		return
+	}
+	plan = *importJobPlan
+	plan.Timeouts = importJobTimeouts
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ImportJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state ImportJobModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed import job value from API
+	readImportJobResponse, err := r.client.GetApiV2ClustersClusterIdImportJobsImportJobId(ctx, state.ClusterId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting import job",
+			"Could not read import job, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// CrateDB Cloud eventually forgets finished import jobs. The imported
+	// data stays, so the last known state is kept instead of re-triggering
+	// the import. Only an import of an unknown job is removed from state.
+	if isNotFound(readImportJobResponse) {
+		_ = readImportJobResponse.Body.Close()
+		if state.Table.IsNull() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		tflog.Warn(ctx, "Import job no longer exists, keeping the last known state", map[string]any{"import_job_id": state.Id.ValueString()})
+		return
+	}
+
+	var importJob apiImportJob
+	if err := decodeApiResponse(readImportJobResponse, http.StatusOK, &importJob); err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting import job",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	importJobState, err := getImportJobModel(ctx, state.ClusterId.ValueString(), importJob)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting import job model",
+			err.Error(),
+		)
+		return
+	}
+	importJobState.Timeouts = state.Timeouts
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, importJobState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Every argument of an import job forces a replacement, so only the timeouts
+// can change here.
+func (r *ImportJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ImportJobModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ImportJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ImportJobModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing import job, which cancels it when it is still running
+	deleteImportJobResponse, err := r.client.DeleteApiV2ClustersClusterIdImportJobsImportJobIdWithResponse(ctx, state.ClusterId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting import job",
+			"Could not delete import job, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// An import job already deleted out-of-band is fine: the desired outcome
+	// (no import job) is achieved.
+	if isNotFound(deleteImportJobResponse.HTTPResponse) {
+		return
+	}
+
+	if deleteImportJobResponse.StatusCode() != 204 {
+		resp.Diagnostics.AddError(
+			"Error deleting import job",
+			apiErrorDetail(deleteImportJobResponse.HTTPResponse, deleteImportJobResponse.Body),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ImportJobResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := clientFromProviderData(req.ProviderData, "Resource", &resp.Diagnostics); client != nil {
+		r.client = client
+	}
+}
+
+func (r *ImportJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import jobs belong to a cluster, so the import identifier combines the
+	// cluster id and the import job id.
+	parts, err := splitImportId(req.ID, "cluster_id/import_job_id")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccImportJobResource imports a public sample dataset into a new table
+// of an existing cluster. The table is left behind, as the provider does not
+// manage tables.
+func TestAccImportJobResource(t *testing.T) {
+	clusterID := envOrSkip(t, "CRATEDB_CLUSTER_ID")
+	table := acctest.RandomWithPrefix("tf_acc_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig + fmt.Sprintf(`
+resource "cratedb_import_job" "test" {
+  cluster_id  = %q
+  table       = %q
+  format      = "csv"
+  compression = "gzip"
+
+  url = {
+    url = "https://github.com/crate/cratedb-datasets/raw/main/cloud-tutorials/data_weather.csv.gz"
+  }
+}
+`, clusterID, table),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cratedb_import_job.test", "status", "SUCCEEDED"),
+					resource.TestCheckResourceAttr("cratedb_import_job.test", "create_table", "true"),
+					resource.TestCheckResourceAttrSet("cratedb_import_job.test", "id"),
+					resource.TestCheckResourceAttrSet("cratedb_import_job.test", "records"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "cratedb_import_job.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportStateIdFunc("cratedb_import_job.test", "cluster_id", "id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
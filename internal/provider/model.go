@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/thulasirajkomminar/cratedb-cloud-go"
 )
 
@@ -60,3 +61,48 @@ func intPointerToInt64Value(v *int) types.Int64 {
 	}
 	return types.Int64Value(int64(*v))
 }
+
+// int32ValueToIntPointer converts an Int32 value to an optional API integer,
+// omitting null and unknown values.
+func int32ValueToIntPointer(v types.Int32) *int {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	i := int(v.ValueInt32())
+	return &i
+}
+
+// int64ValueToIntPointer converts an Int64 value to an optional API integer,
+// omitting null and unknown values.
+func int64ValueToIntPointer(v types.Int64) *int {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	i := int(v.ValueInt64())
+	return &i
+}
+
+// unknownUnlessConfigured returns an object that keeps the configured
+// attributes of config and marks all others unknown, for planning values that
+// an update will recompute.
+func unknownUnlessConfigured(ctx context.Context, config types.Object, attrTypes map[string]attr.Type) (types.Object, error) {
+	attributes := make(map[string]attr.Value, len(attrTypes))
+	for name, attrType := range attrTypes {
+		if value, ok := config.Attributes()[name]; ok && !value.IsNull() {
+			attributes[name] = value
+			continue
+		}
+
+		unknown, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), tftypes.UnknownValue))
+		if err != nil {
+			return types.ObjectUnknown(attrTypes), fmt.Errorf("error getting unknown %s value: %w", name, err)
+		}
+		attributes[name] = unknown
+	}
+
+	object, diags := types.ObjectValue(attrTypes, attributes)
+	if diags.HasError() {
+		return types.ObjectUnknown(attrTypes), fmt.Errorf("error getting object value: %v", diags.Errors())
+	}
+	return object, nil
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/thulasirajkomminar/cratedb-cloud-go"
 )
 
@@ -42,12 +45,17 @@ func (d *OrganizationDataSource) Schema(ctx context.Context, req datasource.Sche
 				Description: "The notification email used in the organization.",
 			},
 			"id": schema.StringAttribute{
-				Required:    true,
-				Description: "The id of the organization.",
+				Computed:    true,
+				Optional:    true,
+				Description: "The id of the organization. Exactly one of `id` or `name` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"name": schema.StringAttribute{
 				Computed:    true,
-				Description: "The name of the organization.",
+				Optional:    true,
+				Description: "The name of the organization. The lookup fails unless exactly one organization has this name.",
 			},
 			"notifications_enabled": schema.BoolAttribute{
 				Computed:    true,
@@ -101,25 +109,56 @@ func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	readOrganizationResponse, err := d.client.GetApiV2OrganizationsOrganizationIdWithResponse(ctx, state.Id.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error getting organization",
-			err.Error(),
-		)
-		return
-	}
+	var organization *cratedb.Organization
+	if !state.Id.IsNull() {
+		readOrganizationResponse, err := d.client.GetApiV2OrganizationsOrganizationIdWithResponse(ctx, state.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error getting organization",
+				err.Error(),
+			)
+			return
+		}
 
-	if readOrganizationResponse.StatusCode() != 200 || readOrganizationResponse.JSON200 == nil {
-		resp.Diagnostics.AddError(
-			"Error getting organization",
-			apiErrorDetail(readOrganizationResponse.HTTPResponse, readOrganizationResponse.Body),
-		)
-		return
+		if readOrganizationResponse.StatusCode() != 200 || readOrganizationResponse.JSON200 == nil {
+			resp.Diagnostics.AddError(
+				"Error getting organization",
+				apiErrorDetail(readOrganizationResponse.HTTPResponse, readOrganizationResponse.Body),
+			)
+			return
+		}
+		organization = readOrganizationResponse.JSON200
+	} else {
+		readOrganizationsResponse, err := d.client.GetApiV2OrganizationsWithResponse(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error getting organizations",
+				err.Error(),
+			)
+			return
+		}
+
+		if readOrganizationsResponse.StatusCode() != 200 || readOrganizationsResponse.JSON200 == nil {
+			resp.Diagnostics.AddError(
+				"Error getting organizations",
+				apiErrorDetail(readOrganizationsResponse.HTTPResponse, readOrganizationsResponse.Body),
+			)
+			return
+		}
+
+		organization, err = findByName(*readOrganizationsResponse.JSON200, state.Name.ValueString(), "organization", func(o cratedb.Organization) string { return o.Name })
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Error looking up organization",
+				err.Error(),
+			)
+			return
+		}
 	}
 
 	// Map response body to model
-	organizationState, err := getOrganizationModel(ctx, *readOrganizationResponse.JSON200)
+	organizationState, err := getOrganizationModel(ctx, *organization)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting organization model",
@@ -0,0 +1,373 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &OrganizationMemberResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationMemberResource{}
+	_ resource.ResourceWithImportState = &OrganizationMemberResource{}
+)
+
+// NewOrganizationMemberResource is a helper function to simplify the provider implementation.
+func NewOrganizationMemberResource() resource.Resource {
+	return &OrganizationMemberResource{}
+}
+
+// OrganizationMemberResource defines the resource implementation.
+type OrganizationMemberResource struct {
+	client *cratedb.ClientWithResponses
+}
+
+// OrganizationMemberModel maps CrateDB organization member schema data.
+type OrganizationMemberModel struct {
+	Email          types.String `tfsdk:"email"`
+	Id             types.String `tfsdk:"id"`
+	Invited        types.Bool   `tfsdk:"invited"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	Role           types.String `tfsdk:"role"`
+	UserId         types.String `tfsdk:"user_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *OrganizationMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_member"
+}
+
+// Schema defines the schema for the resource.
+func (r *OrganizationMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the membership and role of a user in an organization. Adding an email address that has no CrateDB Cloud account yet invites the user.",
+
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The email address of the user. Exactly one of `email` or `user_id` must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user_id")),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The id of the organization member, in the form `<organization_id>/<user_id>`. While an invited user has not accepted the invitation and has no user id yet, it is `<organization_id>/<email>` and changes to the user id form once the invitation is accepted.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invited": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the user was invited and has not accepted the invitation yet.",
+			},
+			"organization_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Required:    true,
+				Description: "The role of the user in the organization, either `org_admin` or `org_member`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(cratedb.OrganizationRoleRoleFqnOrgAdmin),
+						string(cratedb.OrganizationRoleRoleFqnOrgMember),
+					),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The id of the user. Exactly one of `email` or `user_id` must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *OrganizationMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OrganizationMemberModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setRole(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating organization member",
+			"Could not add organization member, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *OrganizationMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state OrganizationMemberModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := r.read(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting organization member",
+			err.Error(),
+		)
+		return
+	}
+
+	// If the user is no longer a member, remove it from state so Terraform
+	// plans to add it again. Pending invitations are not listed, so they are
+	// kept as they are.
+	if !found && !state.Invited.ValueBool() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *OrganizationMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state OrganizationMemberModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the role can change in place, so address the user known from state
+	plan.Email = state.Email
+	plan.UserId = state.UserId
+	if err := r.setRole(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating organization member",
+			"Could not update organization member role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *OrganizationMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OrganizationMemberModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the user from the organization
+	userIdOrEmail := state.UserId.ValueString()
+	if userIdOrEmail == "" {
+		userIdOrEmail = state.Email.ValueString()
+	}
+
+	deleteMemberResponse, err := r.client.DeleteApiV2OrganizationsOrganizationIdUsersUserIdOrEmailWithResponse(ctx, state.OrganizationId.ValueString(), userIdOrEmail)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting organization member",
+			"Could not remove organization member, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// A member already removed out-of-band is fine: the desired outcome (no
+	// membership) is achieved.
+	if isNotFound(deleteMemberResponse.HTTPResponse) {
+		return
+	}
+
+	if deleteMemberResponse.StatusCode() != 204 {
+		resp.Diagnostics.AddError(
+			"Error deleting organization member",
+			apiErrorDetail(deleteMemberResponse.HTTPResponse, deleteMemberResponse.Body),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *OrganizationMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := clientFromProviderData(req.ProviderData, "Resource", &resp.Diagnostics); client != nil {
+		r.client = client
+	}
+}
+
+func (r *OrganizationMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Read looks the member up by organization and user id or email address,
+	// so the import identifier combines both, like the id of the member.
+	parts, err := splitImportId(req.ID, "organization_id/user_id_or_email")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, organizationMemberImportAttribute(parts[1]), parts[1])...)
+}
+
+// organizationMemberImportAttribute returns the attribute an imported member
+// is looked up by: the email address when the identifier is one, and the user
+// id otherwise.
+func organizationMemberImportAttribute(user string) path.Path {
+	if strings.Contains(user, "@") {
+		return path.Root("email")
+	}
+	return path.Root("user_id")
+}
+
+// setRole adds the member to the organization or updates its role, and
+// refreshes the model.
+func (r *OrganizationMemberResource) setRole(ctx context.Context, model *OrganizationMemberModel) error {
+	user := model.UserId.ValueString()
+	if model.UserId.IsNull() || model.UserId.IsUnknown() {
+		user = model.Email.ValueString()
+	}
+
+	setRoleRequest := cratedb.OrganizationRole{
+		RoleFqn: cratedb.OrganizationRoleRoleFqn(model.Role.ValueString()),
+		User:    &user,
+	}
+
+	setRoleResponse, err := r.client.PostApiV2OrganizationsOrganizationIdUsersWithResponse(ctx, model.OrganizationId.ValueString(), setRoleRequest)
+	if err != nil {
+		return err
+	}
+
+	role := setRoleResponse.JSON201
+	if role == nil {
+		role = setRoleResponse.JSON200
+	}
+	if role == nil {
+		return errors.New(apiErrorDetail(setRoleResponse.HTTPResponse, setRoleResponse.Body))
+	}
+
+	if role.UserId != nil {
+		model.UserId = types.StringValue(*role.UserId)
+	}
+	model.Invited = types.BoolValue(role.Invited != nil && *role.Invited)
+
+	found, err := r.read(ctx, model)
+	if err != nil {
+		return err
+	}
+
+	// An invited user has no account yet and is not listed as a member.
+	if !found {
+		if !model.Invited.ValueBool() {
+			return errors.New("the user was not found in the organization after adding it")
+		}
+		model.Id = types.StringValue(model.OrganizationId.ValueString() + "/" + model.Email.ValueString())
+		if model.UserId.IsUnknown() {
+			model.UserId = types.StringNull()
+		}
+	}
+	return nil
+}
+
+// read looks the member up in the users of the organization and refreshes
+// the model. It reports whether the member was found.
+func (r *OrganizationMemberResource) read(ctx context.Context, model *OrganizationMemberModel) (bool, error) {
+	organizationId := model.OrganizationId.ValueString()
+
+	readUsersResponse, err := r.client.GetApiV2OrganizationsOrganizationIdUsersWithResponse(ctx, organizationId)
+	if err != nil {
+		return false, err
+	}
+
+	// If the organization no longer exists, neither does the membership.
+	if isNotFound(readUsersResponse.HTTPResponse) {
+		return false, nil
+	}
+
+	if readUsersResponse.StatusCode() != 200 || readUsersResponse.JSON200 == nil {
+		return false, errors.New(apiErrorDetail(readUsersResponse.HTTPResponse, readUsersResponse.Body))
+	}
+
+	for _, user := range *readUsersResponse.JSON200 {
+		if !sameUser(user.Uid, string(user.Email), model.UserId, model.Email) {
+			continue
+		}
+
+		model.Id = types.StringValue(organizationId + "/" + types.StringPointerValue(user.Uid).ValueString())
+		// Keep the configured spelling of the email address.
+		if !strings.EqualFold(model.Email.ValueString(), string(user.Email)) {
+			model.Email = types.StringValue(string(user.Email))
+		}
+		model.UserId = types.StringPointerValue(user.Uid)
+		model.Invited = types.BoolValue(false)
+		if user.OrganizationRoles != nil {
+			for _, role := range *user.OrganizationRoles {
+				if role.OrganizationId == nil || *role.OrganizationId == organizationId {
+					model.Role = types.StringValue(string(role.RoleFqn))
+				}
+			}
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// sameUser reports whether a listed user is the one a member model refers
+// to, by user id when it is known and by email address otherwise. Email
+// addresses are compared case-insensitively.
+func sameUser(uid *string, email string, userId, userEmail types.String) bool {
+	if !userId.IsNull() && !userId.IsUnknown() {
+		return uid != nil && *uid == userId.ValueString()
+	}
+	return strings.EqualFold(email, userEmail.ValueString())
+}
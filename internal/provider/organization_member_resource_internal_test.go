@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+func TestOrganizationMemberImportState(t *testing.T) {
+	const organizationID = "667796de-3c06-4503-bc3c-a9adc2a849cc"
+
+	ctx := context.Background()
+	r := &OrganizationMemberResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	memberSchema := schemaResp.Schema
+
+	testCases := map[string]struct {
+		id         string
+		wantUserID types.String
+		wantEmail  types.String
+		wantErr    bool
+	}{
+		"user id": {
+			id:         organizationID + "/0f1e3c5d-8a2b-4c6d-9e7f-1a2b3c4d5e6f",
+			wantUserID: types.StringValue("0f1e3c5d-8a2b-4c6d-9e7f-1a2b3c4d5e6f"),
+			wantEmail:  types.StringNull(),
+		},
+		"email": {
+			id:         organizationID + "/jane.doe@example.com",
+			wantUserID: types.StringNull(),
+			wantEmail:  types.StringValue("jane.doe@example.com"),
+		},
+		"missing user": {
+			id:      organizationID,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{Schema: memberSchema, Raw: testResourceValue(t, memberSchema, map[string]attr.Value{})},
+			}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: testCase.id}, resp)
+			if resp.Diagnostics.HasError() != testCase.wantErr {
+				t.Fatalf("expected error %t, got diagnostics %v", testCase.wantErr, resp.Diagnostics)
+			}
+			if testCase.wantErr {
+				return
+			}
+
+			var organizationId, userId, email types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("organization_id"), &organizationId)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("user_id"), &userId)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("email"), &email)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if organizationId.ValueString() != organizationID {
+				t.Errorf("expected organization_id %q, got %s", organizationID, organizationId)
+			}
+			if !userId.Equal(testCase.wantUserID) {
+				t.Errorf("expected user_id %s, got %s", testCase.wantUserID, userId)
+			}
+			if !email.Equal(testCase.wantEmail) {
+				t.Errorf("expected email %s, got %s", testCase.wantEmail, email)
+			}
+		})
+	}
+}
+
+func TestOrganizationMemberReadInvited(t *testing.T) {
+	const (
+		organizationID = "667796de-3c06-4503-bc3c-a9adc2a849cc"
+		userID         = "0f1e3c5d-8a2b-4c6d-9e7f-1a2b3c4d5e6f"
+		email          = "jane.doe@example.com"
+	)
+
+	testCases := map[string]struct {
+		users       string
+		wantFound   bool
+		wantID      string
+		wantUserID  types.String
+		wantInvited bool
+	}{
+		"invitation pending": {
+			users:       `[]`,
+			wantID:      organizationID + "/" + email,
+			wantUserID:  types.StringNull(),
+			wantInvited: true,
+		},
+		"invitation accepted": {
+			users:      `[{"uid":"` + userID + `","email":"Jane.Doe@example.com","organization_roles":[{"organization_id":"` + organizationID + `","role_fqn":"org_member"}]}]`,
+			wantFound:  true,
+			wantID:     organizationID + "/" + userID,
+			wantUserID: types.StringValue(userID),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v2/organizations/"+organizationID+"/users/" {
+					http.NotFound(w, r)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(testCase.users))
+			}))
+			defer server.Close()
+
+			client, err := cratedb.NewClientWithResponses(server.URL)
+			if err != nil {
+				t.Fatalf("creating client: %v", err)
+			}
+			r := &OrganizationMemberResource{client: client}
+
+			// An invited user is addressed by email address until it has
+			// accepted the invitation.
+			model := OrganizationMemberModel{
+				Email:          types.StringValue(email),
+				Id:             types.StringValue(organizationID + "/" + email),
+				Invited:        types.BoolValue(true),
+				OrganizationId: types.StringValue(organizationID),
+				Role:           types.StringValue("org_member"),
+				UserId:         types.StringNull(),
+			}
+
+			found, err := r.read(context.Background(), &model)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if found != testCase.wantFound {
+				t.Errorf("expected found %t, got %t", testCase.wantFound, found)
+			}
+			if model.Id.ValueString() != testCase.wantID {
+				t.Errorf("expected id %q, got %s", testCase.wantID, model.Id)
+			}
+			if !model.UserId.Equal(testCase.wantUserID) {
+				t.Errorf("expected user_id %s, got %s", testCase.wantUserID, model.UserId)
+			}
+			if model.Invited.ValueBool() != testCase.wantInvited {
+				t.Errorf("expected invited %t, got %s", testCase.wantInvited, model.Invited)
+			}
+			if model.Email.ValueString() != email {
+				t.Errorf("expected the configured email %q to be kept, got %s", email, model.Email)
+			}
+		})
+	}
+}
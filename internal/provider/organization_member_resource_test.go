@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccOrganizationMemberResource adds CRATEDB_MEMBER_EMAIL to the test
+// organization and removes it again, so it must not be a member already.
+func TestAccOrganizationMemberResource(t *testing.T) {
+	organizationID := envOrSkip(t, "CRATEDB_ORGANIZATION_ID")
+	email := envOrSkip(t, "CRATEDB_MEMBER_EMAIL")
+
+	memberConfig := func(role string) string {
+		return testAccProviderConfig + fmt.Sprintf(`
+resource "cratedb_organization_member" "test" {
+  organization_id = %q
+  email           = %q
+  role            = %q
+}
+`, organizationID, email, role)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: memberConfig("org_member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cratedb_organization_member.test", "email", email),
+					resource.TestCheckResourceAttr("cratedb_organization_member.test", "role", "org_member"),
+					resource.TestCheckResourceAttr("cratedb_organization_member.test", "invited", "false"),
+					resource.TestCheckResourceAttrSet("cratedb_organization_member.test", "user_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cratedb_organization_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: memberConfig("org_admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cratedb_organization_member.test", "role", "org_admin"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
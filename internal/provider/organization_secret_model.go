@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// Types of organization secrets.
+const (
+	organizationSecretTypeAws   = "AWS"
+	organizationSecretTypeAzure = "AZURE"
+)
+
+// OrganizationSecretModel maps CrateDB organization secret schema data. The
+// secret values are never returned by the API.
+type OrganizationSecretModel struct {
+	Dc          types.Object `tfsdk:"dc"`
+	Description types.String `tfsdk:"description"`
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+}
+
+func getOrganizationSecretModel(ctx context.Context, secret cratedb.Secret) (*OrganizationSecretModel, error) {
+	dcObjectValue, err := getDCObjectValue(ctx, secret.Dc)
+	if err != nil {
+		return nil, fmt.Errorf("error getting organization secret DC value: %w", err)
+	}
+
+	return &OrganizationSecretModel{
+		Dc:          dcObjectValue,
+		Description: types.StringPointerValue(secret.Description),
+		Id:          types.StringPointerValue(secret.Id),
+		Name:        types.StringPointerValue(secret.Name),
+		Type:        types.StringPointerValue(secret.Type),
+	}, nil
+}
+
+// listOrganizationSecrets lists the secrets of an organization.
+func listOrganizationSecrets(ctx context.Context, client *cratedb.ClientWithResponses, organizationId string) ([]cratedb.Secret, error) {
+	readSecretsResponse, err := client.GetApiV2OrganizationsOrganizationIdSecretsWithResponse(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+	if readSecretsResponse.StatusCode() != 200 || readSecretsResponse.JSON200 == nil {
+		return nil, errors.New(apiErrorDetail(readSecretsResponse.HTTPResponse, readSecretsResponse.Body))
+	}
+	return *readSecretsResponse.JSON200, nil
+}
@@ -0,0 +1,391 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &OrganizationSecretResource{}
+	_ resource.ResourceWithConfigure      = &OrganizationSecretResource{}
+	_ resource.ResourceWithImportState    = &OrganizationSecretResource{}
+	_ resource.ResourceWithValidateConfig = &OrganizationSecretResource{}
+)
+
+// NewOrganizationSecretResource is a helper function to simplify the provider implementation.
+func NewOrganizationSecretResource() resource.Resource {
+	return &OrganizationSecretResource{}
+}
+
+// OrganizationSecretResource defines the resource implementation.
+type OrganizationSecretResource struct {
+	client *cratedb.ClientWithResponses
+}
+
+// OrganizationSecretResourceModel describes the resource data model. The
+// write-only values are only available in the configuration.
+type OrganizationSecretResourceModel struct {
+	OrganizationSecretModel
+	AwsAccessKeyWo          types.String `tfsdk:"aws_access_key_wo"`
+	AwsSecretKeyWo          types.String `tfsdk:"aws_secret_key_wo"`
+	AzureConnectionStringWo types.String `tfsdk:"azure_connection_string_wo"`
+	OrganizationId          types.String `tfsdk:"organization_id"`
+	ValueWoVersion          types.Int64  `tfsdk:"value_wo_version"`
+}
+
+// Metadata returns the resource type name.
+func (r *OrganizationSecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_secret"
+}
+
+// Schema defines the schema for the resource.
+func (r *OrganizationSecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Creates and manages a secret of an organization, e.g. the credentials import jobs use to read from object storage. " +
+			"The secret values are write-only and never stored in the Terraform state, which requires Terraform 1.11 or later. " +
+			"CrateDB Cloud secrets cannot be changed, so changing any argument or `value_wo_version` replaces the secret.",
+
+		Attributes: map[string]schema.Attribute{
+			"aws_access_key_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "The AWS access key id. Required when `type` is `AWS`.",
+			},
+			"aws_secret_key_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "The AWS secret access key. Required when `type` is `AWS`.",
+			},
+			"azure_connection_string_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "The Azure Storage connection string. Required when `type` is `AZURE`.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The description CrateDB Cloud derives from the secret, e.g. the masked access key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The id of the secret.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the secret.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of the secret, either `AWS` or `AZURE`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(organizationSecretTypeAws, organizationSecretTypeAzure),
+				},
+			},
+			"value_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The version of the write-only secret values. Changing it replaces the secret with the current values, which rotates it.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"dc": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The DublinCore of the secret.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"created": schema.StringAttribute{
+						CustomType:  timetypes.RFC3339Type{},
+						Computed:    true,
+						Description: "The created time.",
+					},
+					"modified": schema.StringAttribute{
+						CustomType:  timetypes.RFC3339Type{},
+						Computed:    true,
+						Description: "The modified time.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the secret values of the configured type are set.
+func (r *OrganizationSecretResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config OrganizationSecretResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Type.IsUnknown() || config.Type.IsNull() {
+		return
+	}
+	secretType := config.Type.ValueString()
+
+	secretValues := []struct {
+		attribute  string
+		secretType string
+		value      types.String
+	}{
+		{"aws_access_key_wo", organizationSecretTypeAws, config.AwsAccessKeyWo},
+		{"aws_secret_key_wo", organizationSecretTypeAws, config.AwsSecretKeyWo},
+		{"azure_connection_string_wo", organizationSecretTypeAzure, config.AzureConnectionStringWo},
+	}
+
+	for _, secretValue := range secretValues {
+		switch {
+		case secretValue.secretType == secretType && secretValue.value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(secretValue.attribute),
+				"Missing secret value",
+				fmt.Sprintf("Attribute %s must be set when type is %q.", secretValue.attribute, secretType),
+			)
+		case secretValue.secretType != secretType && !secretValue.value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(secretValue.attribute),
+				"Unexpected secret value",
+				fmt.Sprintf("Attribute %s cannot be set when type is %q.", secretValue.attribute, secretType),
+			)
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *OrganizationSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config OrganizationSecretResourceModel
+
+	// Read Terraform plan data into the model, and the write-only secret
+	// values from the configuration
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	data := &cratedb.NestedSecret{}
+	switch plan.Type.ValueString() {
+	case organizationSecretTypeAws:
+		data.AwsSecret = &cratedb.AwsSecret{
+			AccessKey: config.AwsAccessKeyWo.ValueStringPointer(),
+			SecretKey: config.AwsSecretKeyWo.ValueStringPointer(),
+		}
+	case organizationSecretTypeAzure:
+		data.AzureSecret = &cratedb.AzureSecret{
+			ConnectionString: config.AzureConnectionStringWo.ValueStringPointer(),
+		}
+	}
+
+	createSecretRequest := cratedb.Secret{
+		Data: data,
+		Name: plan.Name.ValueStringPointer(),
+		Type: plan.Type.ValueStringPointer(),
+	}
+
+	createSecretResponse, err := r.client.PostApiV2OrganizationsOrganizationIdSecretsWithResponse(ctx, plan.OrganizationId.ValueString(), createSecretRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating organization secret",
+			"Could not create organization secret, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if createSecretResponse.StatusCode() != 201 || createSecretResponse.JSON201 == nil {
+		resp.Diagnostics.AddError(
+			"Error creating organization secret",
+			apiErrorDetail(createSecretResponse.HTTPResponse, createSecretResponse.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	secretPlan, err := getOrganizationSecretModel(ctx, *createSecretResponse.JSON201)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting organization secret model",
+			err.Error(),
+		)
+		return
+	}
+	plan.OrganizationSecretModel = *secretPlan
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *OrganizationSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state OrganizationSecretResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed secret value from API. Secrets can only be listed.
+	secrets, err := listOrganizationSecrets(ctx, r.client, state.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting organization secret",
+			err.Error(),
+		)
+		return
+	}
+
+	var secret *cratedb.Secret
+	for i := range secrets {
+		if secrets[i].Id != nil && *secrets[i].Id == state.Id.ValueString() {
+			secret = &secrets[i]
+		}
+	}
+
+	// If the secret no longer exists, remove it from state so Terraform
+	// plans a re-create instead of failing the refresh.
+	if secret == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Map response body to model
+	secretState, err := getOrganizationSecretModel(ctx, *secret)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting organization secret model",
+			err.Error(),
+		)
+		return
+	}
+	// Overwrite items with refreshed state
+	state.OrganizationSecretModel = *secretState
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Every argument of a secret forces a replacement, so there is nothing to
+// send to the API.
+func (r *OrganizationSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan OrganizationSecretResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *OrganizationSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OrganizationSecretResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing secret
+	deleteSecretResponse, err := r.client.DeleteApiV2OrganizationsOrganizationIdSecretsSecretIdWithResponse(ctx, state.OrganizationId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting organization secret",
+			"Could not delete organization secret, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// A secret already deleted out-of-band is fine: the desired outcome (no
+	// secret) is achieved.
+	if isNotFound(deleteSecretResponse.HTTPResponse) {
+		return
+	}
+
+	if deleteSecretResponse.StatusCode() != 204 {
+		resp.Diagnostics.AddError(
+			"Error deleting organization secret",
+			apiErrorDetail(deleteSecretResponse.HTTPResponse, deleteSecretResponse.Body),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *OrganizationSecretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := clientFromProviderData(req.ProviderData, "Resource", &resp.Diagnostics); client != nil {
+		r.client = client
+	}
+}
+
+func (r *OrganizationSecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Secrets are listed per organization, so the import identifier combines
+	// the organization id and the secret id.
+	parts, err := splitImportId(req.ID, "organization_id/secret_id")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &OrganizationSecretsDataSource{}
+	_ datasource.DataSourceWithConfigure = &OrganizationSecretsDataSource{}
+)
+
+// NewOrganizationSecretsDataSource is a helper function to simplify the provider implementation.
+func NewOrganizationSecretsDataSource() datasource.DataSource {
+	return &OrganizationSecretsDataSource{}
+}
+
+// OrganizationSecretsDataSource is the data source implementation.
+type OrganizationSecretsDataSource struct {
+	client *cratedb.ClientWithResponses
+}
+
+// OrganizationSecretsDataSourceModel describes the data source data model.
+type OrganizationSecretsDataSourceModel struct {
+	OrganizationId types.String              `tfsdk:"organization_id"`
+	Secrets        []OrganizationSecretModel `tfsdk:"secrets"`
+}
+
+// Metadata returns the data source type name.
+func (d *OrganizationSecretsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_secrets"
+}
+
+// Schema defines the schema for the data source.
+func (d *OrganizationSecretsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "To retrieve the secrets of an organization. The secret values are never returned.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the organization.",
+			},
+			"secrets": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The list of secrets.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The description CrateDB Cloud derives from the secret, e.g. the masked access key.",
+						},
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The id of the secret.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the secret.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the secret, e.g. `AWS` or `AZURE`.",
+						},
+						"dc": schema.SingleNestedAttribute{
+							Computed:    true,
+							Description: "The DublinCore of the secret.",
+							Attributes: map[string]schema.Attribute{
+								"created": schema.StringAttribute{
+									CustomType:  timetypes.RFC3339Type{},
+									Computed:    true,
+									Description: "The created time.",
+								},
+								"modified": schema.StringAttribute{
+									CustomType:  timetypes.RFC3339Type{},
+									Computed:    true,
+									Description: "The modified time.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *OrganizationSecretsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := clientFromProviderData(req.ProviderData, "Data Source", &resp.Diagnostics); client != nil {
+		d.client = client
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *OrganizationSecretsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state OrganizationSecretsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secrets, err := listOrganizationSecrets(ctx, d.client, state.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting organization secrets",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Secrets = []OrganizationSecretModel{}
+	for _, secret := range secrets {
+		secretState, err := getOrganizationSecretModel(ctx, secret)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error getting organization secret model",
+				err.Error(),
+			)
+			return
+		}
+		state.Secrets = append(state.Secrets, *secretState)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ProductsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ProductsDataSource{}
+)
+
+// NewProductsDataSource is a helper function to simplify the provider implementation.
+func NewProductsDataSource() datasource.DataSource {
+	return &ProductsDataSource{}
+}
+
+// ProductsDataSource is the data source implementation.
+type ProductsDataSource struct {
+	client *cratedb.ClientWithResponses
+}
+
+// ProductsDataSourceModel describes the data source data model.
+type ProductsDataSourceModel struct {
+	Region   types.String   `tfsdk:"region"`
+	Name     types.String   `tfsdk:"name"`
+	Tier     types.String   `tfsdk:"tier"`
+	Products []ProductModel `tfsdk:"products"`
+}
+
+// ProductModel maps CrateDB product schema data.
+type ProductModel struct {
+	Deprecated        types.Bool   `tfsdk:"deprecated"`
+	Description       types.String `tfsdk:"description"`
+	Kind              types.String `tfsdk:"kind"`
+	Label             types.String `tfsdk:"label"`
+	Name              types.String `tfsdk:"name"`
+	Offer             types.String `tfsdk:"offer"`
+	Plan              types.String `tfsdk:"plan"`
+	PricePerDtuMinute types.Int64  `tfsdk:"price_per_dtu_minute"`
+	Region            types.String `tfsdk:"region"`
+	ScaleSummary      types.String `tfsdk:"scale_summary"`
+	Scaling           types.List   `tfsdk:"scaling"`
+	Specs             types.Object `tfsdk:"specs"`
+	Tags              types.List   `tfsdk:"tags"`
+	Tier              types.String `tfsdk:"tier"`
+}
+
+// ProductScaleOptionModel maps CrateDB product scale option schema data.
+type ProductScaleOptionModel struct {
+	Description    types.String `tfsdk:"description"`
+	Nodes          types.Int64  `tfsdk:"nodes"`
+	PricePerMinute types.Int64  `tfsdk:"price_per_minute"`
+	ProductUnit    types.Int64  `tfsdk:"product_unit"`
+}
+
+func (p ProductScaleOptionModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"description":      types.StringType,
+		"nodes":            types.Int64Type,
+		"price_per_minute": types.Int64Type,
+		"product_unit":     types.Int64Type,
+	}}
+}
+
+// ProductSpecsModel maps CrateDB product specs schema data.
+type ProductSpecsModel struct {
+	CpuCores            types.Float64 `tfsdk:"cpu_cores"`
+	RamBytes            types.Int64   `tfsdk:"ram_bytes"`
+	StorageBytes        types.Int64   `tfsdk:"storage_bytes"`
+	StorageMaximumBytes types.Int64   `tfsdk:"storage_maximum_bytes"`
+	StorageMinimumBytes types.Int64   `tfsdk:"storage_minimum_bytes"`
+}
+
+func (p ProductSpecsModel) GetAttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"cpu_cores":             types.Float64Type,
+		"ram_bytes":             types.Int64Type,
+		"storage_bytes":         types.Int64Type,
+		"storage_maximum_bytes": types.Int64Type,
+		"storage_minimum_bytes": types.Int64Type,
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *ProductsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_products"
+}
+
+// Schema defines the schema for the data source.
+func (d *ProductsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "To retrieve the product catalogue, i.e. the valid `product_name`, `product_tier` and `product_unit` combinations of a `cratedb_cluster`, optionally filtered.",
+
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return products available in this region.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return products with this name, e.g. `cr4`.",
+			},
+			"tier": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return products of this tier, e.g. `default`.",
+			},
+			"products": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The list of products.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"deprecated": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the product is deprecated.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The description of the product.",
+						},
+						"kind": schema.StringAttribute{
+							Computed:    true,
+							Description: "The kind of the product, e.g. `cluster`.",
+						},
+						"label": schema.StringAttribute{
+							Computed:    true,
+							Description: "The display name of the product.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the product, used as `product_name` of a cluster.",
+						},
+						"offer": schema.StringAttribute{
+							Computed:    true,
+							Description: "The offer of the product.",
+						},
+						"plan": schema.StringAttribute{
+							Computed:    true,
+							Description: "The plan of the product.",
+						},
+						"price_per_dtu_minute": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The price per DTU and minute, as reported by the API.",
+						},
+						"region": schema.StringAttribute{
+							Computed:    true,
+							Description: "The region the product is available in.",
+						},
+						"scale_summary": schema.StringAttribute{
+							Computed:    true,
+							Description: "A summary of the sizes the product can be scaled to.",
+						},
+						"scaling": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The sizes the product can be scaled to.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"description": schema.StringAttribute{
+										Computed:    true,
+										Description: "The description of the size.",
+									},
+									"nodes": schema.Int64Attribute{
+										Computed:    true,
+										Description: "The number of nodes of the size.",
+									},
+									"price_per_minute": schema.Int64Attribute{
+										Computed:    true,
+										Description: "The price per minute of the size, as reported by the API.",
+									},
+									"product_unit": schema.Int64Attribute{
+										Computed:    true,
+										Description: "The product unit of the size, used as `product_unit` of a cluster.",
+									},
+								},
+							},
+						},
+						"specs": schema.SingleNestedAttribute{
+							Computed:    true,
+							Description: "The hardware of a node of the product.",
+							Attributes: map[string]schema.Attribute{
+								"cpu_cores": schema.Float64Attribute{
+									Computed:    true,
+									Description: "The number of CPU cores.",
+								},
+								"ram_bytes": schema.Int64Attribute{
+									Computed:    true,
+									Description: "The memory in bytes.",
+								},
+								"storage_bytes": schema.Int64Attribute{
+									Computed:    true,
+									Description: "The default storage in bytes.",
+								},
+								"storage_maximum_bytes": schema.Int64Attribute{
+									Computed:    true,
+									Description: "The maximum storage in bytes.",
+								},
+								"storage_minimum_bytes": schema.Int64Attribute{
+									Computed:    true,
+									Description: "The minimum storage in bytes.",
+								},
+							},
+						},
+						"tags": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The tags of the product.",
+						},
+						"tier": schema.StringAttribute{
+							Computed:    true,
+							Description: "The tier of the product, used as `product_tier` of a cluster.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProductsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := clientFromProviderData(req.ProviderData, "Data Source", &resp.Diagnostics); client != nil {
+		d.client = client
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProductsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ProductsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The name and tier filters are applied by the API.
+	readProductsResponse, err := d.client.GetApiV2ProductsWithResponse(ctx, &cratedb.GetApiV2ProductsParams{
+		Name: state.Name.ValueStringPointer(),
+		Tier: state.Tier.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting products",
+			err.Error(),
+		)
+		return
+	}
+
+	if readProductsResponse.StatusCode() != 200 || readProductsResponse.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Error getting products",
+			apiErrorDetail(readProductsResponse.HTTPResponse, readProductsResponse.Body),
+		)
+		return
+	}
+
+	state.Products = []ProductModel{}
+	for _, product := range *readProductsResponse.JSON200 {
+		if !state.Region.IsNull() && (product.Region == nil || *product.Region != state.Region.ValueString()) {
+			continue
+		}
+
+		productState, err := getProductModel(ctx, product)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error getting product model",
+				err.Error(),
+			)
+			return
+		}
+		state.Products = append(state.Products, *productState)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func getProductModel(ctx context.Context, product cratedb.Product) (*ProductModel, error) {
+	specs := types.ObjectNull(ProductSpecsModel{}.GetAttrType())
+	if product.Specs != nil {
+		cpuCores := types.Float64Null()
+		if product.Specs.CpuCores != nil {
+			cpuCores = types.Float64Value(float64(*product.Specs.CpuCores))
+		}
+
+		specsValue, specsDiags := types.ObjectValueFrom(ctx, ProductSpecsModel{}.GetAttrType(), ProductSpecsModel{
+			CpuCores:            cpuCores,
+			RamBytes:            intPointerToInt64Value(product.Specs.RamBytes),
+			StorageBytes:        intPointerToInt64Value(product.Specs.StorageBytes),
+			StorageMaximumBytes: intPointerToInt64Value(product.Specs.StorageMaximumBytes),
+			StorageMinimumBytes: intPointerToInt64Value(product.Specs.StorageMinimumBytes),
+		})
+		if specsDiags.HasError() {
+			return nil, fmt.Errorf("error getting product specs value: %v", specsDiags.Errors())
+		}
+		specs = specsValue
+	}
+
+	var scaleOptions []ProductScaleOptionModel
+	if product.Scaling != nil {
+		for _, scaleOption := range *product.Scaling {
+			scaleOptions = append(scaleOptions, ProductScaleOptionModel{
+				Description:    types.StringPointerValue(scaleOption.Description),
+				Nodes:          intPointerToInt64Value(scaleOption.Nodes),
+				PricePerMinute: intPointerToInt64Value(scaleOption.PricePerMinute),
+				ProductUnit:    intPointerToInt64Value(scaleOption.Value),
+			})
+		}
+	}
+	scaling, diags := types.ListValueFrom(ctx, ProductScaleOptionModel{}.GetAttrType(), scaleOptions)
+	if diags.HasError() {
+		return nil, fmt.Errorf("error getting product scaling value: %v", diags.Errors())
+	}
+
+	var tagValues []string
+	if product.Tags != nil {
+		tagValues = *product.Tags
+	}
+	tags, diags := types.ListValueFrom(ctx, types.StringType, tagValues)
+	if diags.HasError() {
+		return nil, fmt.Errorf("error getting product tags value: %v", diags.Errors())
+	}
+
+	return &ProductModel{
+		Deprecated:        types.BoolPointerValue(product.Deprecated),
+		Description:       types.StringPointerValue(product.Description),
+		Kind:              types.StringPointerValue(product.Kind),
+		Label:             types.StringPointerValue(product.Label),
+		Name:              types.StringPointerValue(product.Name),
+		Offer:             types.StringPointerValue(product.Offer),
+		Plan:              types.StringPointerValue(product.Plan),
+		PricePerDtuMinute: intPointerToInt64Value(product.PricePerDtuMinute),
+		Region:            types.StringPointerValue(product.Region),
+		ScaleSummary:      types.StringPointerValue(product.ScaleSummary),
+		Scaling:           scaling,
+		Specs:             specs,
+		Tags:              tags,
+		Tier:              types.StringPointerValue(product.Tier),
+	}, nil
+}
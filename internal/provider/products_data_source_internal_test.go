@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+func TestGetProductModel(t *testing.T) {
+	// Shape returned by the live products endpoint, shortened.
+	payload := `{
+		"name": "cr1",
+		"tier": "default",
+		"region": "aks1.westeurope.azure",
+		"kind": "cluster",
+		"deprecated": false,
+		"price_per_dtu_minute": 120,
+		"specs": {"cpu_cores": 0.5, "ram_bytes": 2147483648, "storage_minimum_bytes": 34359738368, "storage_maximum_bytes": 1099511627776},
+		"scaling": [
+			{"value": 0, "nodes": 1, "price_per_minute": 120, "storage_bytes": 34359738368},
+			{"value": 2, "nodes": 3, "price_per_minute": 360, "storage_bytes": null}
+		],
+		"tags": ["general-purpose"]
+	}`
+
+	var product cratedb.Product
+	if err := json.Unmarshal([]byte(payload), &product); err != nil {
+		t.Fatalf("unmarshalling product payload: %v", err)
+	}
+
+	productState, err := getProductModel(context.Background(), product)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var specs ProductSpecsModel
+	if diags := productState.Specs.As(context.Background(), &specs, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("converting specs: %v", diags)
+	}
+	if specs.CpuCores.ValueFloat64() != 0.5 || specs.StorageMaximumBytes.ValueInt64() != 1099511627776 {
+		t.Errorf("unexpected specs %+v", specs)
+	}
+	if !specs.StorageBytes.IsNull() {
+		t.Errorf("expected no storage_bytes, got %d", specs.StorageBytes.ValueInt64())
+	}
+
+	var scaling []ProductScaleOptionModel
+	if diags := productState.Scaling.ElementsAs(context.Background(), &scaling, false); diags.HasError() {
+		t.Fatalf("converting scaling: %v", diags)
+	}
+	if len(scaling) != 2 || scaling[1].ProductUnit.ValueInt64() != 2 || scaling[1].Nodes.ValueInt64() != 3 {
+		t.Errorf("unexpected scaling %+v", scaling)
+	}
+	if productState.Name.ValueString() != "cr1" || productState.PricePerDtuMinute.ValueInt64() != 120 || len(productState.Tags.Elements()) != 1 {
+		t.Errorf("unexpected product %+v", productState)
+	}
+}
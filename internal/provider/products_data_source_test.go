@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccProductsDataSource looks up the free tier, which every region
+// offers.
+func TestAccProductsDataSource(t *testing.T) {
+	region := discoverRegion(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + fmt.Sprintf(`
+data "cratedb_products" "test" {
+  region = %q
+  name   = "crfree"
+}
+`, region),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cratedb_products.test", "products.0.name", "crfree"),
+					resource.TestCheckResourceAttr("data.cratedb_products.test", "products.0.region", region),
+					resource.TestCheckResourceAttrSet("data.cratedb_products.test", "products.0.tier"),
+					resource.TestCheckResourceAttrSet("data.cratedb_products.test", "products.0.specs.ram_bytes"),
+				),
+			},
+		},
+	})
+}
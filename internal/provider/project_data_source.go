@@ -2,10 +2,14 @@ package provider
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/thulasirajkomminar/cratedb-cloud-go"
 )
 
@@ -54,16 +58,22 @@ func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				},
 			},
 			"id": schema.StringAttribute{
-				Required:    true,
-				Description: "The id of the project.",
+				Computed:    true,
+				Optional:    true,
+				Description: "The id of the project. Exactly one of `id` or `name` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"name": schema.StringAttribute{
 				Computed:    true,
-				Description: "The name of the project.",
+				Optional:    true,
+				Description: "The name of the project. The lookup fails unless exactly one project has this name.",
 			},
 			"organization_id": schema.StringAttribute{
 				Computed:    true,
-				Description: "The organization id of the project.",
+				Optional:    true,
+				Description: "The organization id of the project. When set, the project is only looked up by name in this organization.",
 			},
 			"region": schema.StringAttribute{
 				Computed:    true,
@@ -89,25 +99,48 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	readProjectResponse, err := d.client.GetApiV2ProjectsProjectIdWithResponse(ctx, state.Id.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error getting project",
-			err.Error(),
-		)
-		return
-	}
+	var project *cratedb.Project
+	if !state.Id.IsNull() {
+		readProjectResponse, err := d.client.GetApiV2ProjectsProjectIdWithResponse(ctx, state.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error getting project",
+				err.Error(),
+			)
+			return
+		}
 
-	if readProjectResponse.StatusCode() != 200 || readProjectResponse.JSON200 == nil {
-		resp.Diagnostics.AddError(
-			"Error getting project",
-			apiErrorDetail(readProjectResponse.HTTPResponse, readProjectResponse.Body),
-		)
-		return
+		if readProjectResponse.StatusCode() != 200 || readProjectResponse.JSON200 == nil {
+			resp.Diagnostics.AddError(
+				"Error getting project",
+				apiErrorDetail(readProjectResponse.HTTPResponse, readProjectResponse.Body),
+			)
+			return
+		}
+		project = readProjectResponse.JSON200
+	} else {
+		projects, err := listProjects(ctx, d.client, state.OrganizationId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error getting projects",
+				err.Error(),
+			)
+			return
+		}
+
+		project, err = findByName(projects, state.Name.ValueString(), "project", func(p cratedb.Project) string { return p.Name })
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Error looking up project",
+				err.Error(),
+			)
+			return
+		}
 	}
 
 	// Map response body to model
-	projectState, err := getProjectModel(ctx, *readProjectResponse.JSON200)
+	projectState, err := getProjectModel(ctx, *project)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting project model",
@@ -124,3 +157,27 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 }
+
+// listProjects lists the projects of an organization, or all projects the
+// credentials can access when no organization is given.
+func listProjects(ctx context.Context, client *cratedb.ClientWithResponses, organizationId string) ([]cratedb.Project, error) {
+	if organizationId != "" {
+		readProjectsResponse, err := client.GetApiV2OrganizationsOrganizationIdProjectsWithResponse(ctx, organizationId)
+		if err != nil {
+			return nil, err
+		}
+		if readProjectsResponse.StatusCode() != 200 || readProjectsResponse.JSON200 == nil {
+			return nil, errors.New(apiErrorDetail(readProjectsResponse.HTTPResponse, readProjectsResponse.Body))
+		}
+		return *readProjectsResponse.JSON200, nil
+	}
+
+	readProjectsResponse, err := client.GetApiV2ProjectsWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if readProjectsResponse.StatusCode() != 200 || readProjectsResponse.JSON200 == nil {
+		return nil, errors.New(apiErrorDetail(readProjectsResponse.HTTPResponse, readProjectsResponse.Body))
+	}
+	return *readProjectsResponse.JSON200, nil
+}
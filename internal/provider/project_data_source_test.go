@@ -36,6 +36,24 @@ data "cratedb_project" "test" {
 					resource.TestCheckResourceAttrSet("data.cratedb_project.test", "dc.created"),
 				),
 			},
+			{
+				Config: testAccProviderConfig + fmt.Sprintf(`
+resource "cratedb_project" "test" {
+  name            = %q
+  organization_id = %q
+  region          = %q
+}
+
+data "cratedb_project" "test" {
+  name            = cratedb_project.test.name
+  organization_id = cratedb_project.test.organization_id
+}
+`, name, organizationID, region),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.cratedb_project.test", "id", "cratedb_project.test", "id"),
+					resource.TestCheckResourceAttr("data.cratedb_project.test", "region", region),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ProjectMemberResource{}
+	_ resource.ResourceWithConfigure   = &ProjectMemberResource{}
+	_ resource.ResourceWithImportState = &ProjectMemberResource{}
+)
+
+// NewProjectMemberResource is a helper function to simplify the provider implementation.
+func NewProjectMemberResource() resource.Resource {
+	return &ProjectMemberResource{}
+}
+
+// ProjectMemberResource defines the resource implementation.
+type ProjectMemberResource struct {
+	client *cratedb.ClientWithResponses
+}
+
+// ProjectMemberModel maps CrateDB project member schema data.
+type ProjectMemberModel struct {
+	Email     types.String `tfsdk:"email"`
+	Id        types.String `tfsdk:"id"`
+	ProjectId types.String `tfsdk:"project_id"`
+	Role      types.String `tfsdk:"role"`
+	UserId    types.String `tfsdk:"user_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProjectMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_member"
+}
+
+// Schema defines the schema for the resource.
+func (r *ProjectMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the membership and role of a user in a project. The user must be a member of the organization of the project.",
+
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The email address of the user. Exactly one of `email` or `user_id` must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user_id")),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The id of the project member, in the form `<project_id>/<user_id>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the project.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Required:    true,
+				Description: "The role of the user in the project, either `project_admin` or `project_member`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(cratedb.PartialProjectRoleRoleFqnProjectAdmin),
+						string(cratedb.PartialProjectRoleRoleFqnProjectMember),
+					),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The id of the user. Exactly one of `email` or `user_id` must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ProjectMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectMemberModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setRole(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating project member",
+			"Could not add project member, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProjectMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state ProjectMemberModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := r.read(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting project member",
+			err.Error(),
+		)
+		return
+	}
+
+	// If the user is no longer a member, remove it from state so Terraform
+	// plans to add it again.
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProjectMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ProjectMemberModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the role can change in place, so address the user known from state
+	plan.Email = state.Email
+	plan.UserId = state.UserId
+	if err := r.setRole(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating project member",
+			"Could not update project member role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProjectMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectMemberModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the user from the project
+	deleteMemberResponse, err := r.client.DeleteApiV2ProjectsProjectIdUsersUserIdOrEmailWithResponse(ctx, state.ProjectId.ValueString(), state.UserId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting project member",
+			"Could not remove project member, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// A member already removed out-of-band is fine: the desired outcome (no
+	// membership) is achieved.
+	if isNotFound(deleteMemberResponse.HTTPResponse) {
+		return
+	}
+
+	if deleteMemberResponse.StatusCode() != 204 {
+		resp.Diagnostics.AddError(
+			"Error deleting project member",
+			apiErrorDetail(deleteMemberResponse.HTTPResponse, deleteMemberResponse.Body),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProjectMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := clientFromProviderData(req.ProviderData, "Resource", &resp.Diagnostics); client != nil {
+		r.client = client
+	}
+}
+
+func (r *ProjectMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Read looks the member up by project and user id, so the import
+	// identifier combines both.
+	parts, err := splitImportId(req.ID, "project_id/user_id")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[1])...)
+}
+
+// setRole adds the member to the project or updates its role, and refreshes
+// the model.
+func (r *ProjectMemberResource) setRole(ctx context.Context, model *ProjectMemberModel) error {
+	user := model.UserId.ValueString()
+	if model.UserId.IsNull() || model.UserId.IsUnknown() {
+		user = model.Email.ValueString()
+	}
+
+	setRoleRequest := cratedb.ProjectRole{
+		RoleFqn: cratedb.ProjectRoleRoleFqn(model.Role.ValueString()),
+		User:    &user,
+	}
+
+	setRoleResponse, err := r.client.PostApiV2ProjectsProjectIdUsersWithResponse(ctx, model.ProjectId.ValueString(), setRoleRequest)
+	if err != nil {
+		return err
+	}
+
+	role := setRoleResponse.JSON201
+	if role == nil {
+		role = setRoleResponse.JSON200
+	}
+	if role == nil {
+		return errors.New(apiErrorDetail(setRoleResponse.HTTPResponse, setRoleResponse.Body))
+	}
+
+	if role.UserId != nil {
+		model.UserId = types.StringValue(*role.UserId)
+	}
+
+	found, err := r.read(ctx, model)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.New("the user was not found in the project after adding it")
+	}
+	return nil
+}
+
+// read looks the member up in the users of the project and refreshes the
+// model. It reports whether the member was found.
+func (r *ProjectMemberResource) read(ctx context.Context, model *ProjectMemberModel) (bool, error) {
+	projectId := model.ProjectId.ValueString()
+
+	readUsersResponse, err := r.client.GetApiV2ProjectsProjectIdUsersWithResponse(ctx, projectId)
+	if err != nil {
+		return false, err
+	}
+
+	// If the project no longer exists, neither does the membership.
+	if isNotFound(readUsersResponse.HTTPResponse) {
+		return false, nil
+	}
+
+	if readUsersResponse.StatusCode() != 200 || readUsersResponse.JSON200 == nil {
+		return false, errors.New(apiErrorDetail(readUsersResponse.HTTPResponse, readUsersResponse.Body))
+	}
+
+	for _, user := range *readUsersResponse.JSON200 {
+		if !sameUser(user.Uid, string(user.Email), model.UserId, model.Email) {
+			continue
+		}
+
+		model.Id = types.StringValue(projectId + "/" + types.StringPointerValue(user.Uid).ValueString())
+		// Keep the configured spelling of the email address.
+		if !strings.EqualFold(model.Email.ValueString(), string(user.Email)) {
+			model.Email = types.StringValue(string(user.Email))
+		}
+		model.UserId = types.StringPointerValue(user.Uid)
+		if user.ProjectRoles != nil {
+			for _, role := range *user.ProjectRoles {
+				if role.ProjectId == nil || *role.ProjectId == projectId {
+					model.Role = types.StringValue(string(role.RoleFqn))
+				}
+			}
+		}
+		return true, nil
+	}
+	return false, nil
+}
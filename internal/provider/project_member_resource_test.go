@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccProjectMemberResource adds CRATEDB_MEMBER_EMAIL to the test
+// organization and to a new project, so it must not be a member of the
+// organization already.
+func TestAccProjectMemberResource(t *testing.T) {
+	organizationID := envOrSkip(t, "CRATEDB_ORGANIZATION_ID")
+	email := envOrSkip(t, "CRATEDB_MEMBER_EMAIL")
+	region := discoverRegion(t)
+	name := acctest.RandomWithPrefix("tf-acc-test")
+
+	memberConfig := func(role string) string {
+		return testAccProviderConfig + fmt.Sprintf(`
+resource "cratedb_project" "test" {
+  name            = %q
+  organization_id = %q
+  region          = %q
+}
+
+resource "cratedb_organization_member" "test" {
+  organization_id = %q
+  email           = %q
+  role            = "org_member"
+}
+
+resource "cratedb_project_member" "test" {
+  project_id = cratedb_project.test.id
+  user_id    = cratedb_organization_member.test.user_id
+  role       = %q
+}
+`, name, organizationID, region, organizationID, email, role)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: memberConfig("project_member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cratedb_project_member.test", "email", email),
+					resource.TestCheckResourceAttr("cratedb_project_member.test", "role", "project_member"),
+					resource.TestCheckResourceAttrPair("cratedb_project_member.test", "user_id", "cratedb_organization_member.test", "user_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cratedb_project_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: memberConfig("project_admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cratedb_project_member.test", "role", "project_admin"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -21,7 +22,10 @@ import (
 const defaultURL = "https://console.cratedb.cloud"
 
 // Ensure the implementation satisfies the expected interfaces.
-var _ provider.Provider = &CrateDBProvider{}
+var (
+	_ provider.Provider                       = &CrateDBProvider{}
+	_ provider.ProviderWithEphemeralResources = &CrateDBProvider{}
+)
 
 // CrateDBProvider defines the provider implementation.
 type CrateDBProvider struct {
@@ -191,27 +195,51 @@ func (p *CrateDBProvider) Configure(ctx context.Context, req provider.ConfigureR
 	// type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 	tflog.Info(ctx, "Configured CrateDB client", map[string]any{"success": true})
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *CrateDBProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewApiKeyResource,
 		NewClusterResource,
+		NewExportJobResource,
+		NewFileResource,
+		NewImportJobResource,
+		NewOrganizationMemberResource,
 		NewOrganizationResource,
+		NewOrganizationSecretResource,
+		NewProjectMemberResource,
 		NewProjectResource,
 	}
 }
 
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *CrateDBProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewClusterConnectionEphemeralResource,
+	}
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *CrateDBProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewClusterDataSource,
+		NewClusterOperationsDataSource,
+		NewClusterSnapshotsDataSource,
+		NewClustersDataSource,
+		NewCrateVersionsDataSource,
+		NewExportJobDataSource,
 		NewOrganizationDataSource,
+		NewOrganizationSecretsDataSource,
 		NewOrganizationsDataSource,
+		NewProductsDataSource,
 		NewProjectDataSource,
 		NewProjectsDataSource,
 		NewRegionsDataSource,
+		NewSubscriptionDataSource,
+		NewSubscriptionsDataSource,
 	}
 }
 
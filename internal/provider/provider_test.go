@@ -2,6 +2,7 @@ package provider
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
@@ -10,6 +11,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -64,49 +67,100 @@ func discoverRegion(t *testing.T) string {
 		return v
 	}
 
+	body := testAccDiscover(t, "/api/v2/regions/", "a region", "CRATEDB_REGION")
+
+	var regions []apiRegion
+	if err := json.Unmarshal(body, &regions); err != nil {
+		t.Fatalf("parsing regions discovery response: %v", err)
+	}
+
+	for _, region := range regions {
+		if region.Name == nil {
+			continue
+		}
+		if region.Deprecated != nil && *region.Deprecated {
+			continue
+		}
+		if region.IsEdgeRegion != nil && *region.IsEdgeRegion {
+			continue
+		}
+		return *region.Name
+	}
+
+	t.Skip("no usable region found; set CRATEDB_REGION")
+	return ""
+}
+
+// discoverCrateVersion returns CRATEDB_CRATE_VERSION when set, and otherwise
+// the latest stable CrateDB version from the API so the cluster tests can run
+// without manual version configuration.
+func discoverCrateVersion(t *testing.T) string {
+	t.Helper()
+
+	if v := os.Getenv("CRATEDB_CRATE_VERSION"); v != "" {
+		return v
+	}
+
+	body := testAccDiscover(t, "/api/v2/meta/cratedb-versions/", "a CrateDB version", "CRATEDB_CRATE_VERSION")
+
+	var crateVersions apiCrateVersions
+	if err := json.Unmarshal(body, &crateVersions); err != nil {
+		t.Fatalf("parsing CrateDB versions discovery response: %v", err)
+	}
+
+	if version := latestCrateVersion(crateVersions.CrateVersions["stable"]); version != "" {
+		return version
+	}
+
+	t.Skip("no stable CrateDB version found; set CRATEDB_CRATE_VERSION")
+	return ""
+}
+
+// testAccDiscover reads an API path for the discover helpers. What names the
+// discovered fixture and envVar the variable that skips the discovery.
+func testAccDiscover(t *testing.T, path, what, envVar string) []byte {
+	t.Helper()
+
 	// resource.Test skips without TF_ACC; don't call the API before it does.
 	if os.Getenv("TF_ACC") == "" {
 		t.Skip("TF_ACC must be set for acceptance tests")
 	}
 	testAccPreCheck(t)
 
-	url := strings.TrimRight(envOrDefault("CRATEDB_URL", defaultURL), "/") + "/api/v2/regions/"
+	url := strings.TrimRight(envOrDefault("CRATEDB_URL", defaultURL), "/") + path
 	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
-		t.Fatalf("building regions discovery request: %v", err)
+		t.Fatalf("building discovery request for %s: %v", what, err)
 	}
 	req.SetBasicAuth(os.Getenv("CRATEDB_API_KEY"), os.Getenv("CRATEDB_API_SECRET"))
 	req.Header.Set("Accept", "application/json")
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		t.Fatalf("discovering a region: %v", err)
+		t.Fatalf("discovering %s: %v", what, err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil || resp.StatusCode != http.StatusOK {
-		t.Fatalf("discovering a region: HTTP %d (set CRATEDB_REGION to skip discovery)", resp.StatusCode)
-	}
-
-	var regions []apiRegion
-	if err := json.Unmarshal(body, &regions); err != nil {
-		t.Fatalf("parsing regions discovery response: %v", err)
+		t.Fatalf("discovering %s: HTTP %d (set %s to skip discovery)", what, resp.StatusCode, envVar)
 	}
+	return body
+}
 
-	for _, region := range regions {
-		if region.Name == nil {
-			continue
-		}
-		if region.Deprecated != nil && *region.Deprecated {
-			continue
+// testAccImportStateIdFunc returns the composite import identifier of a
+// resource by joining the given state attributes with slashes.
+func testAccImportStateIdFunc(resourceName string, attributes ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", resourceName)
 		}
-		if region.IsEdgeRegion != nil && *region.IsEdgeRegion {
-			continue
+
+		parts := make([]string, 0, len(attributes))
+		for _, attribute := range attributes {
+			parts = append(parts, rs.Primary.Attributes[attribute])
 		}
-		return *region.Name
+		return strings.Join(parts, "/"), nil
 	}
-
-	t.Skip("no usable region found; set CRATEDB_REGION")
-	return ""
 }
@@ -38,20 +38,40 @@ func (t *apiTime) UnmarshalJSON(data []byte) error {
 	return fmt.Errorf("unsupported timestamp format %q", s)
 }
 
+// apiDublinCore mirrors cratedb.DublinCore with tolerant timestamp decoding.
+type apiDublinCore struct {
+	Created  *apiTime `json:"created"`
+	Modified *apiTime `json:"modified"`
+}
+
+// dublinCore converts the value to the generated client type, so that it can
+// be mapped with getDCObjectValue.
+func (dc *apiDublinCore) dublinCore() *cratedb.DublinCore {
+	if dc == nil {
+		return nil
+	}
+
+	dublinCore := &cratedb.DublinCore{}
+	if dc.Created != nil {
+		dublinCore.Created = &dc.Created.Time
+	}
+	if dc.Modified != nil {
+		dublinCore.Modified = &dc.Modified.Time
+	}
+	return dublinCore
+}
+
 // apiRegion mirrors cratedb.Region with tolerant timestamp decoding.
 type apiRegion struct {
-	Dc *struct {
-		Created  *apiTime `json:"created"`
-		Modified *apiTime `json:"modified"`
-	} `json:"dc"`
-	Deprecated       *bool    `json:"deprecated"`
-	Description      *string  `json:"description"`
-	IsEdgeRegion     *bool    `json:"is_edge_region"`
-	LastSeen         *apiTime `json:"last_seen"`
-	Name             *string  `json:"name"`
-	OrganizationId   *string  `json:"organization_id"`
-	Status           *string  `json:"status"`
-	UpgradeAvailable *bool    `json:"upgrade_available"`
+	Dc               *apiDublinCore `json:"dc"`
+	Deprecated       *bool          `json:"deprecated"`
+	Description      *string        `json:"description"`
+	IsEdgeRegion     *bool          `json:"is_edge_region"`
+	LastSeen         *apiTime       `json:"last_seen"`
+	Name             *string        `json:"name"`
+	OrganizationId   *string        `json:"organization_id"`
+	Status           *string        `json:"status"`
+	UpgradeAvailable *bool          `json:"upgrade_available"`
 }
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -245,18 +265,7 @@ func (d *RegionsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 }
 
 func getRegionModel(ctx context.Context, region apiRegion) (*RegionModel, error) {
-	var dc *cratedb.DublinCore
-	if region.Dc != nil {
-		dc = &cratedb.DublinCore{}
-		if region.Dc.Created != nil {
-			dc.Created = &region.Dc.Created.Time
-		}
-		if region.Dc.Modified != nil {
-			dc.Modified = &region.Dc.Modified.Time
-		}
-	}
-
-	dcObjectValue, err := getDCObjectValue(ctx, dc)
+	dcObjectValue, err := getDCObjectValue(ctx, region.Dc.dublinCore())
 	if err != nil {
 		return nil, fmt.Errorf("error getting region DC value: %w", err)
 	}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &SubscriptionDataSource{}
+	_ datasource.DataSourceWithConfigure = &SubscriptionDataSource{}
+)
+
+// NewSubscriptionDataSource is a helper function to simplify the provider implementation.
+func NewSubscriptionDataSource() datasource.DataSource {
+	return &SubscriptionDataSource{}
+}
+
+// SubscriptionDataSource is the data source implementation.
+type SubscriptionDataSource struct {
+	client *cratedb.ClientWithResponses
+}
+
+// Metadata returns the data source type name.
+func (d *SubscriptionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription"
+}
+
+// Schema defines the schema for the data source.
+func (d *SubscriptionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "To retrieve a subscription, for example to bill a `cratedb_cluster` to it.",
+
+		Attributes: subscriptionDataSourceAttributes(),
+	}
+
+	// The subscription is looked up by either its id or as the active
+	// subscription of an organization.
+	resp.Schema.Attributes["id"] = schema.StringAttribute{
+		Computed:    true,
+		Optional:    true,
+		Description: "The id of the subscription. Exactly one of `id` or `organization_id` must be set.",
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("organization_id")),
+		},
+	}
+	resp.Schema.Attributes["organization_id"] = schema.StringAttribute{
+		Computed:    true,
+		Optional:    true,
+		Description: "The organization id of the subscription. When set, the active subscription of the organization is looked up, which fails unless exactly one subscription is active.",
+	}
+	resp.Schema.Attributes["billing_provider"] = schema.StringAttribute{
+		Computed:    true,
+		Optional:    true,
+		Description: "The billing provider of the subscription, e.g. `stripe`, `aws`, `azure` or `gcp`. When set, only the active subscription of this provider is looked up.",
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRoot("id")),
+		},
+	}
+}
+
+// subscriptionDataSourceAttributes returns the computed attributes of a
+// subscription, shared by the subscription and subscriptions data sources.
+func subscriptionDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"active": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether clusters can be billed to the subscription.",
+		},
+		"billing_model": schema.StringAttribute{
+			Computed:    true,
+			Description: "The billing model of the subscription.",
+		},
+		"billing_provider": schema.StringAttribute{
+			Computed:    true,
+			Description: "The billing provider of the subscription, e.g. `stripe`, `aws`, `azure` or `gcp`.",
+		},
+		"dc": schema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "The DublinCore of the subscription.",
+			Attributes: map[string]schema.Attribute{
+				"created": schema.StringAttribute{
+					CustomType:  timetypes.RFC3339Type{},
+					Computed:    true,
+					Description: "The created time.",
+				},
+				"modified": schema.StringAttribute{
+					CustomType:  timetypes.RFC3339Type{},
+					Computed:    true,
+					Description: "The modified time.",
+				},
+			},
+		},
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The id of the subscription.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the subscription.",
+		},
+		"offer": schema.StringAttribute{
+			Computed:    true,
+			Description: "The marketplace offer of the subscription.",
+		},
+		"organization_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The organization id of the subscription.",
+		},
+		"plan": schema.StringAttribute{
+			Computed:    true,
+			Description: "The plan of the subscription.",
+		},
+		"provisioned": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the subscription has been provisioned by its billing provider.",
+		},
+		"reference": schema.StringAttribute{
+			Computed:    true,
+			Description: "The reference of the subscription at its billing provider.",
+		},
+		"state": schema.StringAttribute{
+			Computed:    true,
+			Description: "The state of the subscription.",
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SubscriptionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := clientFromProviderData(req.ProviderData, "Data Source", &resp.Diagnostics); client != nil {
+		d.client = client
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SubscriptionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state SubscriptionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var subscription *cratedb.Subscription
+	if !state.Id.IsNull() {
+		readSubscriptionResponse, err := d.client.GetApiV2SubscriptionsSubscriptionIdWithResponse(ctx, state.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error getting subscription",
+				err.Error(),
+			)
+			return
+		}
+
+		if readSubscriptionResponse.StatusCode() != 200 || readSubscriptionResponse.JSON200 == nil {
+			resp.Diagnostics.AddError(
+				"Error getting subscription",
+				apiErrorDetail(readSubscriptionResponse.HTTPResponse, readSubscriptionResponse.Body),
+			)
+			return
+		}
+		subscription = readSubscriptionResponse.JSON200
+	} else {
+		subscriptions, err := listSubscriptions(ctx, d.client, state.OrganizationId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error getting subscriptions",
+				err.Error(),
+			)
+			return
+		}
+
+		subscription, err = findActiveSubscription(subscriptions, state.BillingProvider.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("organization_id"),
+				"Error looking up subscription",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// Map response body to model
+	subscriptionState, err := getSubscriptionModel(ctx, *subscription)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting subscription model",
+			err.Error(),
+		)
+		return
+	}
+	state = *subscriptionState
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSubscriptionDataSource(t *testing.T) {
+	organizationID := envOrSkip(t, "CRATEDB_ORGANIZATION_ID")
+	subscriptionID := envOrSkip(t, "CRATEDB_SUBSCRIPTION_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + fmt.Sprintf(`
+data "cratedb_subscription" "test" {
+  id = %q
+}
+
+data "cratedb_subscriptions" "test" {
+  organization_id = %q
+}
+`, subscriptionID, organizationID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cratedb_subscription.test", "organization_id", organizationID),
+					resource.TestCheckResourceAttrSet("data.cratedb_subscription.test", "billing_provider"),
+					resource.TestCheckResourceAttrSet("data.cratedb_subscription.test", "state"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.cratedb_subscriptions.test", "subscriptions.*", map[string]string{
+						"id":              subscriptionID,
+						"organization_id": organizationID,
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// SubscriptionModel maps CrateDB subscription schema data.
+type SubscriptionModel struct {
+	Active          types.Bool   `tfsdk:"active"`
+	BillingModel    types.String `tfsdk:"billing_model"`
+	BillingProvider types.String `tfsdk:"billing_provider"`
+	Dc              types.Object `tfsdk:"dc"`
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Offer           types.String `tfsdk:"offer"`
+	OrganizationId  types.String `tfsdk:"organization_id"`
+	Plan            types.String `tfsdk:"plan"`
+	Provisioned     types.Bool   `tfsdk:"provisioned"`
+	Reference       types.String `tfsdk:"reference"`
+	State           types.String `tfsdk:"state"`
+}
+
+func getSubscriptionModel(ctx context.Context, subscription cratedb.Subscription) (*SubscriptionModel, error) {
+	dcObjectValue, err := getDCObjectValue(ctx, subscription.Dc)
+	if err != nil {
+		return nil, fmt.Errorf("error getting subscription DC value: %w", err)
+	}
+
+	return &SubscriptionModel{
+		Active:          types.BoolPointerValue(subscription.Active),
+		BillingModel:    types.StringPointerValue(subscription.BillingModel),
+		BillingProvider: types.StringPointerValue(subscription.Provider),
+		Dc:              dcObjectValue,
+		Id:              types.StringPointerValue(subscription.Id),
+		Name:            types.StringPointerValue(subscription.Name),
+		Offer:           types.StringPointerValue(subscription.Offer),
+		OrganizationId:  types.StringPointerValue(subscription.OrganizationId),
+		Plan:            types.StringPointerValue(subscription.Plan),
+		Provisioned:     types.BoolPointerValue(subscription.Provisioned),
+		Reference:       types.StringPointerValue(subscription.Reference),
+		State:           types.StringPointerValue(subscription.State),
+	}, nil
+}
+
+// listSubscriptions lists the subscriptions of an organization, or all
+// subscriptions the credentials can access when no organization is given.
+func listSubscriptions(ctx context.Context, client *cratedb.ClientWithResponses, organizationId string) ([]cratedb.Subscription, error) {
+	params := &cratedb.GetApiV2SubscriptionsParams{}
+	if organizationId != "" {
+		params.OrganizationId = &organizationId
+	}
+
+	readSubscriptionsResponse, err := client.GetApiV2SubscriptionsWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if readSubscriptionsResponse.StatusCode() != 200 || readSubscriptionsResponse.JSON200 == nil {
+		return nil, errors.New(apiErrorDetail(readSubscriptionsResponse.HTTPResponse, readSubscriptionsResponse.Body))
+	}
+	return *readSubscriptionsResponse.JSON200, nil
+}
+
+// subscriptionHasProvider reports whether the subscription is billed through
+// the provider. Providers are compared case-insensitively.
+func subscriptionHasProvider(subscription cratedb.Subscription, provider string) bool {
+	return subscription.Provider != nil && strings.EqualFold(*subscription.Provider, provider)
+}
+
+// findActiveSubscription returns the only active subscription, optionally of
+// the given provider. Finding none or several is an error, as a cluster can
+// only be billed to one subscription.
+func findActiveSubscription(subscriptions []cratedb.Subscription, provider string) (*cratedb.Subscription, error) {
+	var matches []cratedb.Subscription
+	for _, subscription := range subscriptions {
+		if subscription.Active == nil || !*subscription.Active {
+			continue
+		}
+		if provider != "" && !subscriptionHasProvider(subscription, provider) {
+			continue
+		}
+		matches = append(matches, subscription)
+	}
+
+	description := "active subscription"
+	if provider != "" {
+		description = fmt.Sprintf("active %s subscription", provider)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no %s was found", description)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("found %d %ss, look it up by id instead", len(matches), description)
+	}
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+func TestFindActiveSubscription(t *testing.T) {
+	subscription := func(id, provider string, active bool) cratedb.Subscription {
+		return cratedb.Subscription{Id: &id, Provider: &provider, Active: &active}
+	}
+	subscriptions := []cratedb.Subscription{
+		subscription("1", "stripe", true),
+		subscription("2", "aws", true),
+		subscription("3", "azure", false),
+		subscription("4", "aws", true),
+	}
+
+	testCases := map[string]struct {
+		subscriptions []cratedb.Subscription
+		provider      string
+		wantID        string
+		wantErr       string
+	}{
+		"provider":                   {subscriptions: subscriptions, provider: "stripe", wantID: "1"},
+		"provider ignores case":      {subscriptions: subscriptions, provider: "STRIPE", wantID: "1"},
+		"only active":                {subscriptions: subscriptions[:1], wantID: "1"},
+		"inactive":                   {subscriptions: subscriptions, provider: "azure", wantErr: "no active azure subscription was found"},
+		"ambiguous":                  {subscriptions: subscriptions, provider: "aws", wantErr: "found 2 active aws subscriptions"},
+		"ambiguous without provider": {subscriptions: subscriptions, wantErr: "found 3 active subscriptions"},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			found, err := findActiveSubscription(testCase.subscriptions, testCase.provider)
+			if testCase.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if *found.Id != testCase.wantID {
+				t.Errorf("expected subscription %s, got %s", testCase.wantID, *found.Id)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/thulasirajkomminar/cratedb-cloud-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &SubscriptionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &SubscriptionsDataSource{}
+)
+
+// NewSubscriptionsDataSource is a helper function to simplify the provider implementation.
+func NewSubscriptionsDataSource() datasource.DataSource {
+	return &SubscriptionsDataSource{}
+}
+
+// SubscriptionsDataSource is the data source implementation.
+type SubscriptionsDataSource struct {
+	client *cratedb.ClientWithResponses
+}
+
+// SubscriptionsDataSourceModel describes the data source data model.
+type SubscriptionsDataSourceModel struct {
+	OrganizationId  types.String        `tfsdk:"organization_id"`
+	BillingProvider types.String        `tfsdk:"billing_provider"`
+	Active          types.Bool          `tfsdk:"active"`
+	Subscriptions   []SubscriptionModel `tfsdk:"subscriptions"`
+}
+
+// Metadata returns the data source type name.
+func (d *SubscriptionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscriptions"
+}
+
+// Schema defines the schema for the data source.
+func (d *SubscriptionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "To retrieve all subscriptions, optionally filtered.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return subscriptions of this organization.",
+			},
+			"billing_provider": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return subscriptions of this billing provider, e.g. `stripe`, `aws`, `azure` or `gcp`.",
+			},
+			"active": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return active, or only inactive, subscriptions.",
+			},
+			"subscriptions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The list of subscriptions.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: subscriptionDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SubscriptionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := clientFromProviderData(req.ProviderData, "Data Source", &resp.Diagnostics); client != nil {
+		d.client = client
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SubscriptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state SubscriptionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscriptions, err := listSubscriptions(ctx, d.client, state.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting subscriptions",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Subscriptions = []SubscriptionModel{}
+	for _, subscription := range subscriptions {
+		if !state.BillingProvider.IsNull() && !subscriptionHasProvider(subscription, state.BillingProvider.ValueString()) {
+			continue
+		}
+		if !state.Active.IsNull() && (subscription.Active != nil && *subscription.Active) != state.Active.ValueBool() {
+			continue
+		}
+
+		subscriptionState, err := getSubscriptionModel(ctx, subscription)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error getting subscription model",
+				err.Error(),
+			)
+			return
+		}
+		state.Subscriptions = append(state.Subscriptions, *subscriptionState)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementations satisfy the expected interfaces.
+var (
+	_ validator.String = cidrValidator{}
+	_ validator.String = backupScheduleValidator{}
+)
+
+// cidrValidator validates that a string is an IPv4 or IPv6 network in CIDR
+// notation.
+type cidrValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v cidrValidator) Description(ctx context.Context) string {
+	return "value must be a network in CIDR notation, e.g. `192.168.0.0/24`"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v cidrValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR",
+			fmt.Sprintf("Attribute %s %s, got: %q.", req.Path, v.Description(ctx), value),
+		)
+		return
+	}
+
+	// The API stores the network address, so host bits would show up as drift.
+	if masked := prefix.Masked(); masked != prefix {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR",
+			fmt.Sprintf("Attribute %s must be a network address without host bits set, got: %q. Did you mean %q?", req.Path, value, masked.String()),
+		)
+	}
+}
+
+// backupScheduleValidator validates that a string is a cron expression that
+// CrateDB Cloud can use as a cluster backup schedule.
+type backupScheduleValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v backupScheduleValidator) Description(ctx context.Context) string {
+	return "value must be a cron expression of the form `<minute> <hours> * * *`, e.g. `0 3,15 * * *`"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v backupScheduleValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v backupScheduleValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := parseBackupSchedule(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid backup schedule",
+			fmt.Sprintf("Attribute %s %s, got: %q: %s.", req.Path, v.Description(ctx), value, err),
+		)
+	}
+}
+
+// parseBackupSchedule parses a cron backup schedule and returns the hours of
+// the day it runs at, in ascending order. CrateDB Cloud schedules backups by
+// hour of the day, so the day-of-month, month and day-of-week fields must be
+// wildcards.
+func parseBackupSchedule(schedule string) ([]int, error) {
+	fields := strings.Fields(schedule)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields, got %d", len(fields))
+	}
+
+	if _, err := expandCronField(fields[0], 59); err != nil {
+		return nil, fmt.Errorf("invalid minute field: %w", err)
+	}
+
+	hours, err := expandCronField(fields[1], 23)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hour field: %w", err)
+	}
+
+	for _, field := range fields[2:] {
+		if field != "*" {
+			return nil, errors.New("backups can only be scheduled by hour of the day, so the day-of-month, month and day-of-week fields must be *")
+		}
+	}
+	return hours, nil
+}
+
+// expandCronField expands a cron field of numbers, ranges, steps and lists
+// thereof to the sorted values between 0 and maximum it matches.
+func expandCronField(field string, maximum int) ([]int, error) {
+	var values []int
+	for _, item := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			parsedStep, err := strconv.Atoi(stepPart)
+			if err != nil || parsedStep < 1 {
+				return nil, fmt.Errorf("invalid step %q", stepPart)
+			}
+			step = parsedStep
+		}
+
+		start, end := 0, maximum
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			startPart, endPart, _ := strings.Cut(rangePart, "-")
+			parsedStart, startErr := strconv.Atoi(startPart)
+			parsedEnd, endErr := strconv.Atoi(endPart)
+			if startErr != nil || endErr != nil || parsedStart > parsedEnd {
+				return nil, fmt.Errorf("invalid range %q", rangePart)
+			}
+			start, end = parsedStart, parsedEnd
+		default:
+			value, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q", rangePart)
+			}
+			start, end = value, value
+			// A step on a single value runs from that value to the maximum.
+			if hasStep {
+				end = maximum
+			}
+		}
+
+		if start < 0 || end > maximum {
+			return nil, fmt.Errorf("%q is out of range 0-%d", item, maximum)
+		}
+		for value := start; value <= end; value += step {
+			values = append(values, value)
+		}
+	}
+
+	slices.Sort(values)
+	return slices.Compact(values), nil
+}
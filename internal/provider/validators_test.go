@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCidrValidator(t *testing.T) {
+	testCases := map[string]struct {
+		value     types.String
+		wantError bool
+	}{
+		"ipv4":           {value: types.StringValue("192.168.0.0/24")},
+		"ipv4 host":      {value: types.StringValue("203.0.113.7/32")},
+		"ipv6":           {value: types.StringValue("2001:db8::/32")},
+		"null":           {value: types.StringNull()},
+		"unknown":        {value: types.StringUnknown()},
+		"missing prefix": {value: types.StringValue("192.168.0.1"), wantError: true},
+		"host bits set":  {value: types.StringValue("192.168.0.1/24"), wantError: true},
+		"not an address": {value: types.StringValue("example.com/24"), wantError: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("cidr"),
+				ConfigValue: testCase.value,
+			}
+			resp := &validator.StringResponse{}
+
+			cidrValidator{}.ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.wantError {
+				t.Errorf("expected error %t, got diagnostics: %v", testCase.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestParseBackupSchedule(t *testing.T) {
+	testCases := map[string]struct {
+		schedule  string
+		wantHours []int
+		wantError bool
+	}{
+		"list":              {schedule: "0 3,15 * * *", wantHours: []int{3, 15}},
+		"single":            {schedule: "30 2 * * *", wantHours: []int{2}},
+		"step":              {schedule: "14 */6 * * *", wantHours: []int{0, 6, 12, 18}},
+		"range":             {schedule: "0 1-3 * * *", wantHours: []int{1, 2, 3}},
+		"range with step":   {schedule: "0 8-20/4 * * *", wantHours: []int{8, 12, 16, 20}},
+		"unsorted list":     {schedule: "0 15,3,15 * * *", wantHours: []int{3, 15}},
+		"too few fields":    {schedule: "0 3 * *", wantError: true},
+		"descriptor":        {schedule: "@daily", wantError: true},
+		"day of week":       {schedule: "0 3 * * MON", wantError: true},
+		"hour out of range": {schedule: "0 24 * * *", wantError: true},
+		"invalid minute":    {schedule: "a 3 * * *", wantError: true},
+		"invalid step":      {schedule: "0 */0 * * *", wantError: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			hours, err := parseBackupSchedule(testCase.schedule)
+			if testCase.wantError {
+				if err == nil {
+					t.Fatalf("expected an error, got hours %v", hours)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(hours, testCase.wantHours) {
+				t.Errorf("got hours %v, want %v", hours, testCase.wantHours)
+			}
+		})
+	}
+}